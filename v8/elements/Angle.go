@@ -13,7 +13,11 @@
 package elements
 
 import (
+	cmp "cmp"
+	drv "database/sql/driver"
+	jsn "encoding/json"
 	fmt "fmt"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	mat "math"
 	reg "regexp"
@@ -39,24 +43,40 @@ func (c *angleClass_) Angle(
 func (c *angleClass_) AngleFromSource(
 	source string,
 ) AngleLike {
-	var matches = c.matcher_.FindStringSubmatch(source)
+	var angle, err = c.ParseAngle(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return angle
+}
+
+func (c *angleClass_) ParseAngle(
+	source string,
+) (
+	angle AngleLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
 	if uti.IsUndefined(matches) {
-		var message = fmt.Sprintf(
-			"An illegal string was passed to the angle constructor method: %s",
+		err = parseErrorClass().ParseErrorFromMatcher(
+			"angle",
+			c.matcher_,
+			c.productions_,
 			source,
 		)
-		panic(message)
+		return
 	}
 	var match = matches[1] // Strip off the leading '~' character.
 	switch match {
 	case "pi", "π":
-		return c.pi_
+		angle = c.pi_
 	case "tau", "τ":
-		return c.tau_
+		angle = c.tau_
 	default:
 		var float, _ = stc.ParseFloat(match, 64)
-		return c.angleFromFloat(float)
+		angle = c.angleFromFloat(float)
 	}
+	return
 }
 
 // Constant Methods
@@ -79,6 +99,57 @@ func (c *angleClass_) Tau() AngleLike {
 
 // Function Methods
 
+// NOTE:
+// This function returns a new angle class that uses the specified precision
+// policy.  The significant digits are only used by the significant precision
+// and must be from 1 to 17.  The shared angle class always uses the locked
+// precision, so a policy never changes the angles of any other caller.
+func (c *angleClass_) WithPrecision(
+	precision Precision,
+	digits uint,
+) (
+	class AngleClassLike,
+	err error,
+) {
+	var configured = *c
+	switch precision {
+	case LockedPrecision, ExactPrecision:
+		// The default significant digits are used for the source form.
+	case SignificantPrecision:
+		if digits < 1 || digits > 17 {
+			err = fmt.Errorf(
+				"The number of significant digits must be from 1 to 17: %v",
+				digits,
+			)
+			return
+		}
+		configured.digits_ = digits
+	default:
+		err = fmt.Errorf(
+			"An invalid angle precision was specified: %v",
+			precision,
+		)
+		return
+	}
+	configured.precision_ = precision
+	class = &configured
+	return
+}
+
+func (c *angleClass_) GetPrecision() Precision {
+	return c.precision_
+}
+
+func (c *angleClass_) GetSignificantDigits() uint {
+	return c.digits_
+}
+
+func (c *angleClass_) Format(
+	angle AngleLike,
+) string {
+	return c.sourceFromAngle(angle_(angle.AsIntrinsic()))
+}
+
 func (c *angleClass_) Inverse(
 	angle AngleLike,
 ) AngleLike {
@@ -134,6 +205,10 @@ func (c *angleClass_) Conjugate(
 func (c *angleClass_) Cosine(
 	angle AngleLike,
 ) float64 {
+	if c.GetPrecision() == ExactPrecision {
+		// No values are locked onto at exact precision.
+		return mat.Cos(angle.AsFloat())
+	}
 	var result_ float64
 	switch angle.AsFloat() {
 	case 0.0:
@@ -157,7 +232,7 @@ func (c *angleClass_) Cosine(
 	default:
 		result_ = mat.Cos(angle.AsFloat())
 	}
-	return result_
+	return c.roundValue(result_)
 }
 
 func (c *angleClass_) ArcCosine(
@@ -170,6 +245,10 @@ func (c *angleClass_) ArcCosine(
 func (c *angleClass_) Sine(
 	angle AngleLike,
 ) float64 {
+	if c.GetPrecision() == ExactPrecision {
+		// No values are locked onto at exact precision.
+		return mat.Sin(angle.AsFloat())
+	}
 	var result_ float64
 	switch angle.AsFloat() {
 	case 0.0:
@@ -193,7 +272,7 @@ func (c *angleClass_) Sine(
 	default:
 		result_ = mat.Sin(angle.AsFloat())
 	}
-	return result_
+	return c.roundValue(result_)
 }
 
 func (c *angleClass_) ArcSine(
@@ -206,6 +285,10 @@ func (c *angleClass_) ArcSine(
 func (c *angleClass_) Tangent(
 	angle AngleLike,
 ) float64 {
+	if c.GetPrecision() == ExactPrecision {
+		// No values are locked onto at exact precision.
+		return mat.Tan(angle.AsFloat())
+	}
 	var result_ float64
 	switch angle.AsFloat() {
 	case 0.0:
@@ -229,7 +312,7 @@ func (c *angleClass_) Tangent(
 	default:
 		result_ = mat.Tan(angle.AsFloat())
 	}
-	return result_
+	return c.roundValue(result_)
 }
 
 func (c *angleClass_) ArcTangent(
@@ -240,6 +323,24 @@ func (c *angleClass_) ArcTangent(
 	return result_
 }
 
+func (c *angleClass_) Compare(
+	first AngleLike,
+	second AngleLike,
+) int {
+	return cmp.Compare(first.AsFloat(), second.AsFloat())
+}
+
+func (c *angleClass_) ApproximatelyEqual(
+	first AngleLike,
+	second AngleLike,
+	tolerance AngleLike,
+) bool {
+	// Measure the shorter way around the circle between the two angles.
+	var difference = mat.Abs(first.AsFloat() - second.AsFloat())
+	difference = min(difference, c.tau_.AsFloat()-difference)
+	return difference <= tolerance.AsFloat()
+}
+
 // INSTANCE INTERFACE
 
 // Principal Methods
@@ -317,6 +418,14 @@ func (v angle_) IsMaximum() bool {
 	return v == angleClass().tau_
 }
 
+// Ordered[AngleLike] Methods
+
+func (v angle_) IsBefore(
+	value AngleLike,
+) bool {
+	return angleClass().Compare(v, value) < 0
+}
+
 // PROTECTED INTERFACE
 
 func (v Units) String() string {
@@ -336,6 +445,146 @@ func (v angle_) String() string {
 	return v.AsSource()
 }
 
+func (v angle_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *angle_) UnmarshalText(
+	text []byte,
+) error {
+	var angle, err = angleClass().ParseAngle(string(text))
+	if err != nil {
+		return err
+	}
+	*v = angle.(angle_)
+	return nil
+}
+
+func (v angle_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *angle_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v angle_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	var radians = v.AsIntrinsic()
+	if !mat.IsNaN(radians) && !mat.IsInf(radians, 0) {
+		return jsn.Marshal(radians)
+	}
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *angle_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	if !jsonClass().isString(bytes) {
+		var radians float64
+		var err = jsn.Unmarshal(bytes, &radians)
+		if err != nil {
+			return err
+		}
+		*v = angleClass().angleFromFloat(radians)
+		return nil
+	}
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v angle_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = formatClass().Header(AngleType)
+	bytes = formatClass().appendFloat(bytes, v.AsIntrinsic())
+	return
+}
+
+func (v *angle_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = formatClass().Payload(AngleType, bytes)
+	if err != nil {
+		return err
+	}
+	var floats []float64
+	floats, err = formatClass().floatsFromPayload(payload, 1)
+	if err != nil {
+		return err
+	}
+	*v = angleClass().angleFromFloat(floats[0])
+	return nil
+}
+
+func (v angle_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	return cborClass().Encode(angleClass().cborTag_, v.AsIntrinsic())
+}
+
+func (v *angle_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = cborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != angleClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not an angle: %x", bytes)
+	}
+	var radians, failure = cborClass().floatFromValue(value)
+	if failure != nil {
+		return failure
+	}
+	*v = angleClass().angleFromFloat(radians)
+	return nil
+}
+
+func (v angle_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *angle_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into an angle: %v",
+			source,
+		)
+	}
+}
+
 // Private Methods
 
 func (c *angleClass_) angleFromFloat(float float64) angle_ {
@@ -346,16 +595,33 @@ func (c *angleClass_) angleFromFloat(float float64) angle_ {
 
 func (c *angleClass_) lockAngle(value float64) float64 {
 	var pi = angleClass().Pi().AsIntrinsic()
-	var value32 = float32(value)
-	switch {
-	case mat.Abs(value) <= 1.2246467991473515e-16:
-		value = 0
-	case value32 == float32(0.5*pi):
-		value = 0.5 * pi
-	case value32 == float32(pi):
-		value = pi
-	case value32 == float32(1.5*pi):
-		value = 1.5 * pi
+	switch c.GetPrecision() {
+	case ExactPrecision:
+		// The double precision value is already exact.
+	case SignificantPrecision:
+		var rounded = c.roundValue(value)
+		switch rounded {
+		case c.roundValue(0.5 * pi):
+			value = 0.5 * pi
+		case c.roundValue(pi):
+			value = pi
+		case c.roundValue(1.5 * pi):
+			value = 1.5 * pi
+		default:
+			value = rounded
+		}
+	default:
+		var value32 = float32(value)
+		switch {
+		case mat.Abs(value) <= 1.2246467991473515e-16:
+			value = 0
+		case value32 == float32(0.5*pi):
+			value = 0.5 * pi
+		case value32 == float32(pi):
+			value = pi
+		case value32 == float32(1.5*pi):
+			value = 1.5 * pi
+		}
 	}
 	return value
 }
@@ -373,6 +639,16 @@ func (c *angleClass_) normalizeValue(value float64) float64 {
 	return value
 }
 
+// This private function rounds the specified value to the significant digits of
+// the class when the class uses significant precision.
+func (c *angleClass_) roundValue(value float64) float64 {
+	if c.GetPrecision() == SignificantPrecision {
+		var digits = int(c.GetSignificantDigits())
+		value, _ = stc.ParseFloat(stc.FormatFloat(value, 'g', digits, 64), 64)
+	}
+	return value
+}
+
 func (c *angleClass_) sourceFromAngle(angle angle_) string {
 	var source string
 	switch angle {
@@ -381,7 +657,15 @@ func (c *angleClass_) sourceFromAngle(angle angle_) string {
 	case c.tau_:
 		source = "~τ"
 	default:
-		source = "~" + stc.FormatFloat(float64(angle), 'G', 15, 64)
+		var digits = 15
+		switch c.GetPrecision() {
+		case ExactPrecision:
+			// Render the shortest source that parses back to the exact value.
+			digits = -1
+		case SignificantPrecision:
+			digits = int(c.GetSignificantDigits())
+		}
+		source = "~" + stc.FormatFloat(float64(angle), 'G', digits, 64)
 	}
 	return source
 }
@@ -394,11 +678,15 @@ type angle_ float64
 
 type angleClass_ struct {
 	// Declare the class constants.
-	matcher_   *reg.Regexp
-	undefined_ AngleLike
-	zero_      AngleLike
-	pi_        AngleLike
-	tau_       AngleLike
+	matcher_     *reg.Regexp
+	productions_ map[int]string
+	undefined_   AngleLike
+	zero_        AngleLike
+	pi_          AngleLike
+	tau_         AngleLike
+	precision_   Precision
+	digits_      uint
+	cborTag_     uint64
 }
 
 // Class Reference
@@ -409,9 +697,15 @@ func angleClass() *angleClass_ {
 
 var angleClassReference_ = &angleClass_{
 	// Initialize the class constants.
-	matcher_:   reg.MustCompile("^~(0|" + amplitude_ + ")"),
+	matcher_: reg.MustCompile("^~(0|" + amplitude_ + ")"),
+	productions_: map[int]string{
+		1: "angle in radians",
+	},
 	undefined_: angle_(mat.NaN()),
 	zero_:      angle_(0.0),
 	pi_:        angle_(mat.Pi),
 	tau_:       angle_(2.0 * mat.Pi),
+	precision_: LockedPrecision,
+	digits_:    15,
+	cborTag_:   0x63646701,
 }
@@ -13,7 +13,10 @@
 package elements
 
 import (
+	drv "database/sql/driver"
+	jsn "encoding/json"
 	fmt "fmt"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	reg "regexp"
 	stc "strconv"
@@ -38,17 +41,33 @@ func (c *booleanClass_) Boolean(
 func (c *booleanClass_) BooleanFromSource(
 	source string,
 ) BooleanLike {
+	var boolean, err = c.ParseBoolean(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return boolean
+}
+
+func (c *booleanClass_) ParseBoolean(
+	source string,
+) (
+	boolean BooleanLike,
+	err error,
+) {
 	// Our booleans are more restrictive than the Go strconv package.
-	var matches = c.matcher_.FindStringSubmatch(source)
+	var matches = gra.MatchSource(c.matcher_, source)
 	if uti.IsUndefined(matches) {
-		var message = fmt.Sprintf(
-			"An illegal string was passed to the boolean constructor method: %s",
+		err = parseErrorClass().ParseErrorFromMatcher(
+			"boolean",
+			c.matcher_,
+			c.productions_,
 			source,
 		)
-		panic(message)
+		return
 	}
-	var boolean, _ = stc.ParseBool(matches[0])
-	return boolean_(boolean)
+	var intrinsic, _ = stc.ParseBool(matches[0])
+	boolean = boolean_(intrinsic)
+	return
 }
 
 // Constant Methods
@@ -153,6 +172,146 @@ func (v boolean_) String() string {
 	return v.AsSource()
 }
 
+func (v boolean_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *boolean_) UnmarshalText(
+	text []byte,
+) error {
+	var boolean, err = booleanClass().ParseBoolean(string(text))
+	if err != nil {
+		return err
+	}
+	*v = boolean.(boolean_)
+	return nil
+}
+
+func (v boolean_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *boolean_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v boolean_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsIntrinsic())
+}
+
+func (v *boolean_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	var intrinsic bool
+	var err = jsn.Unmarshal(bytes, &intrinsic)
+	if err != nil {
+		return err
+	}
+	*v = boolean_(intrinsic)
+	return nil
+}
+
+func (v boolean_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = formatClass().Header(BooleanType)
+	if v.AsIntrinsic() {
+		bytes = append(bytes, 1)
+	} else {
+		bytes = append(bytes, 0)
+	}
+	return
+}
+
+func (v *boolean_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = formatClass().Payload(BooleanType, bytes)
+	if err != nil {
+		return err
+	}
+	if len(payload) != 1 || payload[0] > 1 {
+		return fmt.Errorf("The binary payload is not a boolean: %x", payload)
+	}
+	*v = boolean_(payload[0] == 1)
+	return nil
+}
+
+func (v boolean_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	return cborClass().Encode(0, v.AsIntrinsic())
+}
+
+func (v *boolean_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = cborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != 0 {
+		return fmt.Errorf("The CBOR data item is not a boolean: %x", bytes)
+	}
+	var intrinsic, ok = value.(bool)
+	if !ok {
+		return fmt.Errorf("The CBOR data item is not a boolean: %x", bytes)
+	}
+	*v = boolean_(intrinsic)
+	return nil
+}
+
+func (v boolean_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = bool(v)
+	return
+}
+
+func (v *boolean_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case bool:
+		*v = boolean_(actual)
+		return nil
+	case int64:
+		if actual != 0 && actual != 1 {
+			return fmt.Errorf("A boolean must be either zero or one: %v", actual)
+		}
+		*v = boolean_(actual == 1)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a boolean: %v",
+			source,
+		)
+	}
+}
+
 // Private Methods
 
 // Instance Structure
@@ -163,9 +322,10 @@ type boolean_ bool
 
 type booleanClass_ struct {
 	// Declare the class constants.
-	matcher_ *reg.Regexp
-	false_   BooleanLike
-	true_    BooleanLike
+	matcher_     *reg.Regexp
+	productions_ map[int]string
+	false_       BooleanLike
+	true_        BooleanLike
 }
 
 // Class Reference
@@ -176,7 +336,8 @@ func booleanClass() *booleanClass_ {
 
 var booleanClassReference_ = &booleanClass_{
 	// Initialize the class constants.
-	matcher_: reg.MustCompile("^false|true"),
-	false_:   boolean_(false),
-	true_:    boolean_(true),
+	matcher_:     reg.MustCompile("^false|true"),
+	productions_: map[int]string{},
+	false_:       boolean_(false),
+	true_:        boolean_(true),
 }
@@ -0,0 +1,373 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package elements
+
+import (
+	bin "encoding/binary"
+	fmt "fmt"
+	mat "math"
+	utf "unicode/utf8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func CborClass() CborClassLike {
+	return cborClass()
+}
+
+// Constructor Methods
+
+// Constant Methods
+
+// Function Methods
+
+func (c *cborClass_) Encode(
+	tag uint64,
+	value any,
+) (
+	bytes []byte,
+	err error,
+) {
+	if tag > 0 {
+		bytes = c.appendHead(bytes, 6, tag)
+	}
+	bytes, err = c.appendValue(bytes, value)
+	return
+}
+
+func (c *cborClass_) Decode(
+	bytes []byte,
+) (
+	tag uint64,
+	value any,
+	err error,
+) {
+	var major, argument, size, failure = c.decodeHead(bytes)
+	if failure != nil {
+		err = failure
+		return
+	}
+	if major == 6 {
+		if argument == 0 {
+			err = fmt.Errorf("The CBOR tag zero is not supported.")
+			return
+		}
+		tag = argument
+		bytes = bytes[size:]
+	}
+	var length int
+	value, length, err = c.decodeValue(bytes)
+	if err == nil && length != len(bytes) {
+		err = fmt.Errorf("The CBOR item is followed by extra bytes: %x", bytes[length:])
+	}
+	return
+}
+
+// INSTANCE INTERFACE
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// This private method appends the head of a CBOR data item with the specified
+// major type and argument to the specified bytes.
+func (c *cborClass_) appendHead(
+	bytes []byte,
+	major byte,
+	argument uint64,
+) []byte {
+	var initial = major << 5
+	switch {
+	case argument < 24:
+		bytes = append(bytes, initial|byte(argument))
+	case argument <= mat.MaxUint8:
+		bytes = append(bytes, initial|24, byte(argument))
+	case argument <= mat.MaxUint16:
+		bytes = append(bytes, initial|25)
+		bytes = bin.BigEndian.AppendUint16(bytes, uint16(argument))
+	case argument <= mat.MaxUint32:
+		bytes = append(bytes, initial|26)
+		bytes = bin.BigEndian.AppendUint32(bytes, uint32(argument))
+	default:
+		bytes = append(bytes, initial|27)
+		bytes = bin.BigEndian.AppendUint64(bytes, argument)
+	}
+	return bytes
+}
+
+// This private method appends the CBOR data item for the specified value to the
+// specified bytes.  Floating point values use the shortest of the single and
+// double precision forms that preserves their value.
+func (c *cborClass_) appendValue(
+	bytes []byte,
+	value any,
+) (
+	result []byte,
+	err error,
+) {
+	switch actual := value.(type) {
+	case nil:
+		bytes = append(bytes, 0xf6)
+	case bool:
+		if actual {
+			bytes = append(bytes, 0xf5)
+		} else {
+			bytes = append(bytes, 0xf4)
+		}
+	case uint:
+		bytes = c.appendHead(bytes, 0, uint64(actual))
+	case uint64:
+		bytes = c.appendHead(bytes, 0, actual)
+	case int:
+		bytes, err = c.appendValue(bytes, int64(actual))
+	case int64:
+		if actual < 0 {
+			bytes = c.appendHead(bytes, 1, uint64(-1-actual))
+		} else {
+			bytes = c.appendHead(bytes, 0, uint64(actual))
+		}
+	case float64:
+		var single = float32(actual)
+		if float64(single) == actual || mat.IsNaN(actual) {
+			bytes = append(bytes, 0xfa)
+			bytes = bin.BigEndian.AppendUint32(bytes, mat.Float32bits(single))
+		} else {
+			bytes = append(bytes, 0xfb)
+			bytes = bin.BigEndian.AppendUint64(bytes, mat.Float64bits(actual))
+		}
+	case []byte:
+		bytes = c.appendHead(bytes, 2, uint64(len(actual)))
+		bytes = append(bytes, actual...)
+	case string:
+		bytes = c.appendHead(bytes, 3, uint64(len(actual)))
+		bytes = append(bytes, actual...)
+	case []any:
+		bytes = c.appendHead(bytes, 4, uint64(len(actual)))
+		for _, item := range actual {
+			bytes, err = c.appendValue(bytes, item)
+			if err != nil {
+				return
+			}
+		}
+	default:
+		err = fmt.Errorf("The value cannot be encoded as CBOR: %v", value)
+	}
+	result = bytes
+	return
+}
+
+// This private method decodes the head of the CBOR data item at the start of
+// the specified bytes.
+func (c *cborClass_) decodeHead(
+	bytes []byte,
+) (
+	major byte,
+	argument uint64,
+	size int,
+	err error,
+) {
+	if len(bytes) == 0 {
+		err = fmt.Errorf("The CBOR data item is missing.")
+		return
+	}
+	major = bytes[0] >> 5
+	var additional = bytes[0] & 0x1f
+	switch {
+	case additional < 24:
+		argument = uint64(additional)
+		size = 1
+	case additional < 28:
+		var width = 1 << (additional - 24)
+		if len(bytes) < 1+width {
+			err = fmt.Errorf("The CBOR data item is truncated: %x", bytes)
+			return
+		}
+		for _, byte_ := range bytes[1 : 1+width] {
+			argument = argument<<8 | uint64(byte_)
+		}
+		size = 1 + width
+	default:
+		err = fmt.Errorf("The CBOR data item has an unsupported length: %x", bytes[0])
+	}
+	return
+}
+
+// This private method decodes the CBOR data item at the start of the specified
+// bytes into a Go value and returns the number of bytes that it occupied.  The
+// decoded values are bool, uint64, int64, float64, []byte, string, []any and
+// nil.
+func (c *cborClass_) decodeValue(
+	bytes []byte,
+) (
+	value any,
+	length int,
+	err error,
+) {
+	var major, argument, size, failure = c.decodeHead(bytes)
+	if failure != nil {
+		err = failure
+		return
+	}
+	length = size
+	switch major {
+	case 0:
+		value = argument
+	case 1:
+		if argument > mat.MaxInt64 {
+			err = fmt.Errorf("The CBOR negative integer is too large: %x", bytes)
+			return
+		}
+		value = -1 - int64(argument)
+	case 2, 3:
+		if uint64(len(bytes)-size) < argument {
+			err = fmt.Errorf("The CBOR string is truncated: %x", bytes)
+			return
+		}
+		length += int(argument)
+		var content = bytes[size:length]
+		if major == 2 {
+			value = append([]byte{}, content...)
+			return
+		}
+		if !utf.Valid(content) {
+			err = fmt.Errorf("The CBOR text string is not valid UTF-8: %x", content)
+			return
+		}
+		value = string(content)
+	case 4:
+		var items []any
+		for index := uint64(0); index < argument; index++ {
+			var item, itemLength, failure = c.decodeValue(bytes[length:])
+			if failure != nil {
+				err = failure
+				return
+			}
+			items = append(items, item)
+			length += itemLength
+		}
+		value = items
+	case 7:
+		value, err = c.decodeSimple(bytes[0], argument)
+	default:
+		err = fmt.Errorf("The CBOR data item has an unsupported type: %x", bytes[0])
+	}
+	return
+}
+
+// This private method decodes the simple values and floating point numbers.
+func (c *cborClass_) decodeSimple(
+	initial byte,
+	argument uint64,
+) (
+	value any,
+	err error,
+) {
+	switch initial {
+	case 0xf4:
+		value = false
+	case 0xf5:
+		value = true
+	case 0xf6:
+		value = nil
+	case 0xf9:
+		value = c.floatFromHalf(uint16(argument))
+	case 0xfa:
+		value = float64(mat.Float32frombits(uint32(argument)))
+	case 0xfb:
+		value = mat.Float64frombits(argument)
+	default:
+		err = fmt.Errorf("The CBOR simple value is not supported: %x", initial)
+	}
+	return
+}
+
+// This private method converts a half precision float into a float64.
+func (c *cborClass_) floatFromHalf(
+	half uint16,
+) float64 {
+	var exponent = int(half>>10) & 0x1f
+	var mantissa = float64(half & 0x3ff)
+	var float float64
+	switch exponent {
+	case 0:
+		float = mat.Ldexp(mantissa, -24)
+	case 0x1f:
+		if mantissa == 0 {
+			float = mat.Inf(1)
+		} else {
+			float = mat.NaN()
+		}
+	default:
+		float = mat.Ldexp(mantissa+1024, exponent-25)
+	}
+	if half&0x8000 != 0 {
+		float = -float
+	}
+	return float
+}
+
+// This private method extracts a float from the specified decoded value.
+func (c *cborClass_) floatFromValue(
+	value any,
+) (
+	float float64,
+	err error,
+) {
+	switch actual := value.(type) {
+	case float64:
+		float = actual
+	case uint64:
+		float = float64(actual)
+	case int64:
+		float = float64(actual)
+	default:
+		err = fmt.Errorf("The CBOR value is not a number: %v", value)
+	}
+	return
+}
+
+// This private method extracts an integer from the specified decoded value.
+func (c *cborClass_) integerFromValue(
+	value any,
+) (
+	integer int,
+	err error,
+) {
+	switch actual := value.(type) {
+	case uint64:
+		integer = int(actual)
+	case int64:
+		integer = int(actual)
+	default:
+		err = fmt.Errorf("The CBOR value is not an integer: %v", value)
+	}
+	return
+}
+
+// Class Structure
+
+type cborClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func cborClass() *cborClass_ {
+	return cborClassReference_
+}
+
+var cborClassReference_ = &cborClass_{
+	// Initialize the class constants.
+}
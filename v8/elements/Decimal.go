@@ -0,0 +1,565 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package elements
+
+import (
+	drv "database/sql/driver"
+	jsn "encoding/json"
+	fmt "fmt"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	mat "math"
+	big "math/big"
+	reg "regexp"
+	stc "strconv"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func DecimalClass() DecimalClassLike {
+	return decimalClass()
+}
+
+// Constructor Methods
+
+func (c *decimalClass_) Decimal(
+	unscaled *big.Int,
+	scale uint,
+) DecimalLike {
+	if unscaled == nil {
+		panic("A decimal requires an unscaled value.")
+	}
+	return c.decimalFromParts(unscaled, scale)
+}
+
+func (c *decimalClass_) DecimalFromInteger(
+	integer int,
+) DecimalLike {
+	return c.decimalFromParts(big.NewInt(int64(integer)), 0)
+}
+
+func (c *decimalClass_) DecimalFromFloat(
+	float float64,
+	scale uint,
+) DecimalLike {
+	if mat.IsNaN(float) || mat.IsInf(float, 0) {
+		var message = fmt.Sprintf(
+			"A decimal cannot be created from a non-finite float: %v",
+			float,
+		)
+		panic(message)
+	}
+	var rational = new(big.Rat).SetFloat64(float) // This is exact.
+	var numerator = new(big.Int).Mul(rational.Num(), c.powerOfTen(scale))
+	var unscaled = c.divided(numerator, rational.Denom(), HalfEvenRounding)
+	return c.decimalFromParts(unscaled, scale)
+}
+
+func (c *decimalClass_) DecimalFromSource(
+	source string,
+) DecimalLike {
+	var decimal, err = c.ParseDecimal(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return decimal
+}
+
+func (c *decimalClass_) ParseDecimal(
+	source string,
+) (
+	decimal DecimalLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
+	if uti.IsUndefined(matches) {
+		err = parseErrorClass().ParseErrorFromMatcher(
+			"decimal",
+			c.matcher_,
+			c.productions_,
+			source,
+		)
+		return
+	}
+	var unscaled, scale = c.partsFromSource(matches[1]) // Strip off the 'd' suffix.
+	decimal = c.decimalFromParts(unscaled, scale)
+	return
+}
+
+// Constant Methods
+
+func (c *decimalClass_) Zero() DecimalLike {
+	return c.zero_
+}
+
+func (c *decimalClass_) One() DecimalLike {
+	return c.one_
+}
+
+// Function Methods
+
+func (c *decimalClass_) Inverse(
+	decimal DecimalLike,
+) DecimalLike {
+	var unscaled = new(big.Int).Neg(decimal.GetUnscaled())
+	return c.decimalFromParts(unscaled, decimal.GetScale())
+}
+
+func (c *decimalClass_) Sum(
+	first DecimalLike,
+	second DecimalLike,
+) DecimalLike {
+	var a, b, scale = c.aligned(first, second)
+	return c.decimalFromParts(a.Add(a, b), scale)
+}
+
+func (c *decimalClass_) Difference(
+	first DecimalLike,
+	second DecimalLike,
+) DecimalLike {
+	var a, b, scale = c.aligned(first, second)
+	return c.decimalFromParts(a.Sub(a, b), scale)
+}
+
+func (c *decimalClass_) Product(
+	first DecimalLike,
+	second DecimalLike,
+) DecimalLike {
+	var unscaled = new(big.Int).Mul(first.GetUnscaled(), second.GetUnscaled())
+	return c.decimalFromParts(unscaled, first.GetScale()+second.GetScale())
+}
+
+func (c *decimalClass_) Quotient(
+	first DecimalLike,
+	second DecimalLike,
+	scale uint,
+	rounding Rounding,
+) DecimalLike {
+	c.validateDivisor(second)
+	c.validateRounding(rounding)
+
+	// (a / 10^s1) / (b / 10^s2) = (a * 10^(scale + s2)) / (b * 10^s1) / 10^scale
+	var numerator = new(big.Int).Mul(
+		first.GetUnscaled(),
+		c.powerOfTen(scale+second.GetScale()),
+	)
+	var denominator = new(big.Int).Mul(
+		second.GetUnscaled(),
+		c.powerOfTen(first.GetScale()),
+	)
+	var unscaled = c.divided(numerator, denominator, rounding)
+	return c.decimalFromParts(unscaled, scale)
+}
+
+func (c *decimalClass_) Remainder(
+	first DecimalLike,
+	second DecimalLike,
+) DecimalLike {
+	c.validateDivisor(second)
+	var a, b, scale = c.aligned(first, second)
+	return c.decimalFromParts(a.Rem(a, b), scale) // Truncated toward zero.
+}
+
+func (c *decimalClass_) Rounded(
+	decimal DecimalLike,
+	scale uint,
+	rounding Rounding,
+) DecimalLike {
+	c.validateRounding(rounding)
+	var unscaled = decimal.GetUnscaled()
+	var current = decimal.GetScale()
+	if scale >= current {
+		// Increasing the scale is exact.
+		unscaled.Mul(unscaled, c.powerOfTen(scale-current))
+	} else {
+		unscaled = c.divided(
+			unscaled,
+			c.powerOfTen(current-scale),
+			rounding,
+		)
+	}
+	return c.decimalFromParts(unscaled, scale)
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v decimal_) GetClass() DecimalClassLike {
+	return decimalClass()
+}
+
+func (v decimal_) GetUnscaled() *big.Int {
+	var unscaled, _ = decimalClass().partsFromSource(string(v))
+	return unscaled
+}
+
+func (v decimal_) GetScale() uint {
+	var index = sts.IndexByte(string(v), '.')
+	if index < 0 {
+		return 0
+	}
+	return uint(len(v) - index - 1)
+}
+
+func (v decimal_) AsBigRat() *big.Rat {
+	return new(big.Rat).SetFrac(
+		v.GetUnscaled(),
+		decimalClass().powerOfTen(v.GetScale()),
+	)
+}
+
+// Attribute Methods
+
+// Continuous Methods
+
+func (v decimal_) AsSource() string {
+	return string(v) + "d"
+}
+
+func (v decimal_) AsFloat() float64 {
+	var float, _ = stc.ParseFloat(string(v), 64) // Rounded to the nearest float64.
+	return float
+}
+
+func (v decimal_) HasMagnitude() bool {
+	return !v.IsZero()
+}
+
+func (v decimal_) IsInfinite() bool {
+	return false
+}
+
+func (v decimal_) IsDefined() bool {
+	return true
+}
+
+func (v decimal_) IsMinimum() bool {
+	return false
+}
+
+func (v decimal_) IsZero() bool {
+	return sts.Trim(string(v), "0.") == ""
+}
+
+func (v decimal_) IsMaximum() bool {
+	return false
+}
+
+// Polarized Methods
+
+func (v decimal_) IsNegative() bool {
+	return sts.HasPrefix(string(v), "-")
+}
+
+// PROTECTED INTERFACE
+
+func (v decimal_) String() string {
+	return v.AsSource()
+}
+
+func (v decimal_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *decimal_) UnmarshalText(
+	text []byte,
+) error {
+	var decimal, err = decimalClass().ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = decimal.(decimal_)
+	return nil
+}
+
+func (v decimal_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *decimal_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v decimal_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	// A JSON number preserves every digit of the decimal.
+	return jsn.Marshal(jsn.Number(v))
+}
+
+func (v *decimal_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	if !jsonClass().isString(bytes) {
+		var number jsn.Number
+		var err = jsn.Unmarshal(bytes, &number)
+		if err != nil {
+			return err
+		}
+		var source = string(number) + "d"
+		if decimalClass().matcher_.FindString(source) != source {
+			return fmt.Errorf("The JSON value is not a decimal: %s", bytes)
+		}
+		return v.UnmarshalText([]byte(source))
+	}
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v decimal_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = formatClass().Header(DecimalType)
+	bytes = append(bytes, v.AsSource()...)
+	return
+}
+
+func (v *decimal_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = formatClass().Payload(DecimalType, bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(payload)
+}
+
+func (v decimal_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	return cborClass().Encode(decimalClass().cborTag_, v.AsSource())
+}
+
+func (v *decimal_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = cborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != decimalClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not a decimal: %x", bytes)
+	}
+	var source, ok = value.(string)
+	if !ok {
+		return fmt.Errorf("The CBOR data item is not a decimal: %x", bytes)
+	}
+	return v.UnmarshalText([]byte(source))
+}
+
+func (v decimal_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *decimal_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a decimal: %v",
+			source,
+		)
+	}
+}
+
+// Private Methods
+
+// This private method returns the unscaled values of the specified decimals
+// after aligning them to the larger of their two scales, along with that scale.
+func (c *decimalClass_) aligned(
+	first DecimalLike,
+	second DecimalLike,
+) (
+	a *big.Int,
+	b *big.Int,
+	scale uint,
+) {
+	a = first.GetUnscaled()
+	b = second.GetUnscaled()
+	scale = max(first.GetScale(), second.GetScale())
+	a.Mul(a, c.powerOfTen(scale-first.GetScale()))
+	b.Mul(b, c.powerOfTen(scale-second.GetScale()))
+	return
+}
+
+// This private method returns the canonical decimal for the specified unscaled
+// value and scale.  There is no negative zero.
+func (c *decimalClass_) decimalFromParts(
+	unscaled *big.Int,
+	scale uint,
+) decimal_ {
+	var digits = new(big.Int).Abs(unscaled).String()
+	if len(digits) <= int(scale) {
+		// Make sure there is a digit before the decimal point.
+		digits = sts.Repeat("0", int(scale)-len(digits)+1) + digits
+	}
+	var point = len(digits) - int(scale)
+	var source = digits[:point]
+	if scale > 0 {
+		source += "." + digits[point:]
+	}
+	if unscaled.Sign() < 0 {
+		source = "-" + source
+	}
+	return decimal_(source)
+}
+
+// This private method panics if the specified rounding mode is not one of the
+// supported rounding modes.
+func (c *decimalClass_) validateRounding(
+	rounding Rounding,
+) {
+	switch rounding {
+	case HalfEvenRounding, HalfUpRounding, DownRounding, CeilingRounding,
+		FloorRounding:
+	default:
+		panic("An invalid decimal rounding mode was specified.")
+	}
+}
+
+// This private method returns the quotient of the specified integers rounded
+// to an integer using the specified rounding mode.
+func (c *decimalClass_) divided(
+	numerator *big.Int,
+	denominator *big.Int,
+	rounding Rounding,
+) *big.Int {
+	var negative = (numerator.Sign() < 0) != (denominator.Sign() < 0)
+	var divisor = new(big.Int).Abs(denominator)
+	var quotient, remainder = new(big.Int).QuoRem(
+		new(big.Int).Abs(numerator),
+		divisor,
+		new(big.Int),
+	)
+	if remainder.Sign() != 0 {
+		// Compare twice the remainder with the divisor to detect a tie.
+		var half = new(big.Int).Lsh(remainder, 1).Cmp(divisor)
+		var increment bool
+		switch rounding {
+		case HalfEvenRounding:
+			increment = half > 0 || (half == 0 && quotient.Bit(0) == 1)
+		case HalfUpRounding:
+			increment = half >= 0
+		case DownRounding:
+			increment = false
+		case CeilingRounding:
+			increment = !negative
+		case FloorRounding:
+			increment = negative
+		}
+		if increment {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	if negative {
+		quotient.Neg(quotient)
+	}
+	return quotient
+}
+
+// This private method returns the unscaled value and scale for the specified
+// decimal source without its "d" suffix (e.g. "-12.50").
+func (c *decimalClass_) partsFromSource(
+	source string,
+) (
+	unscaled *big.Int,
+	scale uint,
+) {
+	var index = sts.IndexByte(source, '.')
+	if index >= 0 {
+		scale = uint(len(source) - index - 1)
+		source = source[:index] + source[index+1:]
+	}
+	unscaled, _ = new(big.Int).SetString(source, 10)
+	return
+}
+
+func (c *decimalClass_) powerOfTen(
+	exponent uint,
+) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+}
+
+func (c *decimalClass_) validateDivisor(
+	divisor DecimalLike,
+) {
+	if divisor.IsZero() {
+		panic("A decimal cannot be divided by zero.")
+	}
+}
+
+// Instance Structure
+
+type decimal_ string // The canonical source without its "d" suffix.
+
+// Class Structure
+
+type decimalClass_ struct {
+	// Declare the class constants.
+	matcher_     *reg.Regexp
+	productions_ map[int]string
+	zero_        DecimalLike
+	one_         DecimalLike
+	cborTag_     uint64
+}
+
+// Class Reference
+
+func decimalClass() *decimalClass_ {
+	return decimalClassReference_
+}
+
+var decimalClassReference_ = &decimalClass_{
+	// Initialize the class constants.
+	matcher_: reg.MustCompile(
+		"^((?:" + sign_ + ")?(?:0|" + ordinal_ + ")(?:" + fraction_ + ")?)d",
+	),
+	productions_: map[int]string{
+		1: "decimal number",
+	},
+	zero_:    decimal_("0"),
+	one_:     decimal_("1"),
+	cborTag_: 0x6364670c,
+}
@@ -13,12 +13,18 @@
 package elements
 
 import (
+	cmp "cmp"
+	drv "database/sql/driver"
+	bin "encoding/binary"
+	jsn "encoding/json"
 	fmt "fmt"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	mat "math"
 	reg "regexp"
 	stc "strconv"
 	sts "strings"
+	tim "time"
 )
 
 // CLASS INTERFACE
@@ -32,23 +38,64 @@ func DurationClass() DurationClassLike {
 // Constructor Methods
 
 func (c *durationClass_) Duration(
-	milliseconds uint,
+	milliseconds int,
+) DurationLike {
+	return duration_{milliseconds_: milliseconds}
+}
+
+func (c *durationClass_) DurationWithNanoseconds(
+	milliseconds int,
+	nanoseconds int,
 ) DurationLike {
-	return duration_(milliseconds)
+	var perMillisecond = int(c.nanosecondsPerMillisecond_)
+	if nanoseconds <= -perMillisecond || nanoseconds >= perMillisecond ||
+		(milliseconds < 0 && nanoseconds > 0) || (milliseconds > 0 && nanoseconds < 0) {
+		var message = fmt.Sprintf(
+			"The nanoseconds must be less than a millisecond with the same sign as the milliseconds: %v",
+			nanoseconds,
+		)
+		panic(message)
+	}
+	return duration_{milliseconds, nanoseconds}
+}
+
+func (c *durationClass_) DurationFromTimeDuration(
+	duration tim.Duration,
+) DurationLike {
+	var perMillisecond = tim.Duration(c.nanosecondsPerMillisecond_)
+	var milliseconds = int(duration / perMillisecond)
+	var nanoseconds = int(duration % perMillisecond)
+	return duration_{milliseconds, nanoseconds}
 }
 
 func (c *durationClass_) DurationFromSource(
 	source string,
 ) DurationLike {
-	var matches = c.matcher_.FindStringSubmatch(source)
+	var duration, err = c.ParseDuration(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return duration
+}
+
+func (c *durationClass_) ParseDuration(
+	source string,
+) (
+	duration DurationLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
 	if uti.IsUndefined(matches) {
-		var message = fmt.Sprintf(
-			"An illegal string was passed to the duration constructor method: %s",
+		err = parseErrorClass().ParseErrorFromMatcher(
+			"duration",
+			c.matcher_,
+			c.productions_,
 			source,
 		)
-		panic(message)
+		return
 	}
-	return duration_(c.durationFromMatches(matches))
+	duration = c.durationFromMatches(matches)
+	return
 }
 
 // Constant Methods
@@ -95,6 +142,97 @@ func (c *durationClass_) WeeksPerMonth() float64 {
 
 // Function Methods
 
+func (c *durationClass_) Sum(
+	first DurationLike,
+	second DurationLike,
+) DurationLike {
+	var left = c.durationFrom(first)
+	var right = c.durationFrom(second)
+	return c.normalized(
+		left.milliseconds_+right.milliseconds_,
+		left.nanoseconds_+right.nanoseconds_,
+	)
+}
+
+func (c *durationClass_) Difference(
+	first DurationLike,
+	second DurationLike,
+) DurationLike {
+	var left = c.durationFrom(first)
+	var right = c.durationFrom(second)
+	return c.normalized(
+		left.milliseconds_-right.milliseconds_,
+		left.nanoseconds_-right.nanoseconds_,
+	)
+}
+
+func (c *durationClass_) Scaled(
+	duration DurationLike,
+	factor float64,
+) DurationLike {
+	var scaled = c.durationFrom(duration)
+	var milliseconds = float64(scaled.milliseconds_) * factor
+	var whole = mat.Trunc(milliseconds)
+	var perMillisecond = float64(c.nanosecondsPerMillisecond_)
+	var nanoseconds = mat.Round(
+		(milliseconds-whole)*perMillisecond + float64(scaled.nanoseconds_)*factor,
+	)
+	return c.normalized(int(whole), int(nanoseconds))
+}
+
+func (c *durationClass_) Quotient(
+	first DurationLike,
+	second DurationLike,
+) float64 {
+	return first.AsMilliseconds() / second.AsMilliseconds()
+}
+
+func (c *durationClass_) Minimum(
+	first DurationLike,
+	second DurationLike,
+) DurationLike {
+	if c.Compare(first, second) > 0 {
+		return second
+	}
+	return first
+}
+
+func (c *durationClass_) Maximum(
+	first DurationLike,
+	second DurationLike,
+) DurationLike {
+	if c.Compare(first, second) < 0 {
+		return second
+	}
+	return first
+}
+
+func (c *durationClass_) Compare(
+	first DurationLike,
+	second DurationLike,
+) int {
+	var left = c.durationFrom(first)
+	var right = c.durationFrom(second)
+	var comparison = cmp.Compare(left.milliseconds_, right.milliseconds_)
+	if comparison == 0 {
+		// The sub-millisecond parts share the sign of the milliseconds.
+		comparison = cmp.Compare(left.nanoseconds_, right.nanoseconds_)
+	}
+	return comparison
+}
+
+func (c *durationClass_) ApproximatelyEqual(
+	first DurationLike,
+	second DurationLike,
+	tolerance DurationLike,
+) bool {
+	var difference = c.Difference(first, second)
+	if difference.IsNegative() {
+		difference = c.Difference(second, first)
+	}
+	return c.Compare(difference, tolerance) <= 0
+}
+
 // INSTANCE INTERFACE
 
 // Principal Methods
@@ -103,8 +241,22 @@ func (v duration_) GetClass() DurationClassLike {
 	return durationClass()
 }
 
-func (v duration_) AsIntrinsic() uint {
-	return uint(v)
+func (v duration_) AsIntrinsic() int {
+	return v.milliseconds_
+}
+
+func (v duration_) AsTimeDuration() tim.Duration {
+	var perMillisecond = int(durationClass().nanosecondsPerMillisecond_)
+	var limit = mat.MaxInt64 / perMillisecond
+	switch {
+	case v.milliseconds_ > limit:
+		// The duration saturates beyond about 292 years.
+		return mat.MaxInt64
+	case v.milliseconds_ < -limit:
+		return mat.MinInt64
+	default:
+		return tim.Duration(v.milliseconds_*perMillisecond + v.nanoseconds_)
+	}
 }
 
 // Attribute Methods
@@ -113,7 +265,11 @@ func (v duration_) AsIntrinsic() uint {
 
 func (v duration_) AsSource() string {
 	var builder sts.Builder
-	builder.WriteString("~P")
+	builder.WriteString("~")
+	if v.IsNegative() {
+		builder.WriteString("-")
+	}
+	builder.WriteString("P")
 	var float = mat.Abs(v.AsWeeks())
 	var weeks = uint(float)
 	if float64(weeks) == float {
@@ -141,7 +297,8 @@ func (v duration_) AsSource() string {
 	var minutes = v.GetMinutes()
 	var seconds = v.GetSeconds()
 	var milliseconds = v.GetMilliseconds()
-	if hours+minutes+seconds+milliseconds == 0 {
+	var nanoseconds = v.GetMicroseconds()*1000 + v.GetNanoseconds()
+	if hours+minutes+seconds+milliseconds+nanoseconds == 0 {
 		// There is no time part of the duration.
 		return builder.String()
 	}
@@ -154,11 +311,13 @@ func (v duration_) AsSource() string {
 		builder.WriteString(stc.FormatInt(int64(minutes), 10))
 		builder.WriteString("M")
 	}
-	if seconds+milliseconds > 0 {
+	if seconds+milliseconds+nanoseconds > 0 {
 		builder.WriteString(stc.FormatInt(int64(seconds), 10))
-		if milliseconds > 0 {
+		if milliseconds+nanoseconds > 0 {
 			builder.WriteString(".")
-			builder.WriteString(stc.FormatInt(int64(milliseconds), 10))
+			builder.WriteString(
+				durationClass().formatFraction(milliseconds, nanoseconds),
+			)
 		}
 		builder.WriteString("S")
 	}
@@ -166,64 +325,87 @@ func (v duration_) AsSource() string {
 }
 
 func (v duration_) AsInteger() int {
-	return int(v)
+	return v.milliseconds_
 }
 
 func (v duration_) IsDefined() bool {
-	return v > 0 && v < mat.MaxUint64
+	return v.milliseconds_ > mat.MinInt64 && v.milliseconds_ < mat.MaxInt64
 }
 
 func (v duration_) IsMinimum() bool {
-	return v == 0
+	return v.milliseconds_ == mat.MinInt64
 }
 
 func (v duration_) IsZero() bool {
-	return v == 0
+	return v.milliseconds_ == 0 && v.nanoseconds_ == 0
 }
 
 func (v duration_) IsMaximum() bool {
-	return v == mat.MaxInt64
+	return v.milliseconds_ == mat.MaxInt64
+}
+
+// Polarized Methods
+
+func (v duration_) IsNegative() bool {
+	return v.milliseconds_ < 0 || v.nanoseconds_ < 0
 }
 
 // Temporal Methods
 
 func (v duration_) AsMilliseconds() float64 {
-	return float64(v)
+	var nanoseconds = float64(durationClass().nanosecondsPerMillisecond_)
+	return float64(v.milliseconds_) + float64(v.nanoseconds_)/nanoseconds
 }
 
 func (v duration_) AsSeconds() float64 {
-	return float64(v) / float64(durationClass().millisecondsPerSecond_)
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerSecond_)
 }
 
 func (v duration_) AsMinutes() float64 {
-	return float64(v) / float64(durationClass().millisecondsPerMinute_)
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerMinute_)
 }
 
 func (v duration_) AsHours() float64 {
-	return float64(v) / float64(durationClass().millisecondsPerHour_)
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerHour_)
 }
 
 func (v duration_) AsDays() float64 {
-	return float64(v) / float64(durationClass().millisecondsPerDay_)
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerDay_)
 }
 
 func (v duration_) AsWeeks() float64 {
-	return float64(v) / float64(durationClass().millisecondsPerWeek_)
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerWeek_)
 }
 
 func (v duration_) AsMonths() float64 {
-	return float64(v) / float64(durationClass().millisecondsPerMonth_)
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerMonth_)
 }
 
 func (v duration_) AsYears() float64 {
-	return float64(v) / float64(durationClass().millisecondsPerYear_)
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerYear_)
 }
 
 // Factored Methods
 
+func (v duration_) GetNanoseconds() uint {
+	var nanoseconds = v.nanoseconds_
+	if nanoseconds < 0 {
+		nanoseconds = -nanoseconds
+	}
+	return uint(nanoseconds % 1000)
+}
+
+func (v duration_) GetMicroseconds() uint {
+	var nanoseconds = v.nanoseconds_
+	if nanoseconds < 0 {
+		nanoseconds = -nanoseconds
+	}
+	return uint(nanoseconds / 1000)
+}
+
 func (v duration_) GetMilliseconds() uint {
-	// Retrieve the total number of milliseconds.
-	var milliseconds = v.AsIntrinsic()
+	// Retrieve the magnitude of the total number of milliseconds.
+	var milliseconds = v.magnitude()
 
 	// Strip off everything but the milliseconds.
 	milliseconds = milliseconds % durationClass().millisecondsPerSecond_
@@ -231,8 +413,8 @@ func (v duration_) GetMilliseconds() uint {
 }
 
 func (v duration_) GetSeconds() uint {
-	// Retrieve the total number of milliseconds.
-	var milliseconds = v.AsIntrinsic()
+	// Retrieve the magnitude of the total number of milliseconds.
+	var milliseconds = v.magnitude()
 
 	// Strip off the years.
 	milliseconds = milliseconds - (v.GetYears() * durationClass().millisecondsPerYear_)
@@ -255,8 +437,8 @@ func (v duration_) GetSeconds() uint {
 }
 
 func (v duration_) GetMinutes() uint {
-	// Retrieve the total number of milliseconds.
-	var milliseconds = v.AsIntrinsic()
+	// Retrieve the magnitude of the total number of milliseconds.
+	var milliseconds = v.magnitude()
 
 	// Strip off the years.
 	milliseconds = milliseconds - (v.GetYears() * durationClass().millisecondsPerYear_)
@@ -276,8 +458,8 @@ func (v duration_) GetMinutes() uint {
 }
 
 func (v duration_) GetHours() uint {
-	// Retrieve the total number of milliseconds.
-	var milliseconds = v.AsIntrinsic()
+	// Retrieve the magnitude of the total number of milliseconds.
+	var milliseconds = v.magnitude()
 
 	// Strip off the years.
 	milliseconds = milliseconds - (v.GetYears() * durationClass().millisecondsPerYear_)
@@ -294,8 +476,8 @@ func (v duration_) GetHours() uint {
 }
 
 func (v duration_) GetDays() uint {
-	// Retrieve the total number of milliseconds.
-	var milliseconds = v.AsIntrinsic()
+	// Retrieve the magnitude of the total number of milliseconds.
+	var milliseconds = v.magnitude()
 
 	// Strip off the years.
 	milliseconds = milliseconds - (v.GetYears() * durationClass().millisecondsPerYear_)
@@ -309,8 +491,8 @@ func (v duration_) GetDays() uint {
 }
 
 func (v duration_) GetWeeks() uint {
-	// Retrieve the total number of milliseconds.
-	var milliseconds = v.AsIntrinsic()
+	// Retrieve the magnitude of the total number of milliseconds.
+	var milliseconds = v.magnitude()
 
 	// Strip off the years.
 	milliseconds = milliseconds - (v.GetYears() * durationClass().millisecondsPerYear_)
@@ -321,8 +503,8 @@ func (v duration_) GetWeeks() uint {
 }
 
 func (v duration_) GetMonths() uint {
-	// Retrieve the total number of milliseconds.
-	var milliseconds = v.AsIntrinsic()
+	// Retrieve the magnitude of the total number of milliseconds.
+	var milliseconds = v.magnitude()
 
 	// Strip off the years.
 	milliseconds = milliseconds - (v.GetYears() * durationClass().millisecondsPerYear_)
@@ -333,29 +515,248 @@ func (v duration_) GetMonths() uint {
 }
 
 func (v duration_) GetYears() uint {
-	// Retrieve the total number of milliseconds.
-	var milliseconds = v.AsIntrinsic()
+	// Retrieve the magnitude of the total number of milliseconds.
+	var milliseconds = v.magnitude()
 
 	// Convert to years.
 	var years = milliseconds / durationClass().millisecondsPerYear_
 	return years
 }
 
+// Ordered[DurationLike] Methods
+
+func (v duration_) IsBefore(
+	value DurationLike,
+) bool {
+	return durationClass().Compare(v, value) < 0
+}
+
 // PROTECTED INTERFACE
 
 func (v duration_) String() string {
 	return v.AsSource()
 }
 
+func (v duration_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *duration_) UnmarshalText(
+	text []byte,
+) error {
+	var duration, err = durationClass().ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*v = duration.(duration_)
+	return nil
+}
+
+func (v duration_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *duration_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v duration_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	if v.nanoseconds_ == 0 {
+		return jsn.Marshal(v.AsIntrinsic())
+	}
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *duration_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	if !jsonClass().isString(bytes) {
+		var milliseconds int
+		var err = jsn.Unmarshal(bytes, &milliseconds)
+		if err != nil {
+			return err
+		}
+		*v = duration_{milliseconds_: milliseconds}
+		return nil
+	}
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v duration_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	// The magnitude of the milliseconds is encoded exactly as it was before
+	// durations were signed so that existing payloads still decode.  A sign
+	// byte and the magnitude of any nanoseconds follow it only when needed.
+	bytes = formatClass().Header(DurationType)
+	var milliseconds, nanoseconds = v.milliseconds_, v.nanoseconds_
+	var sign byte
+	if milliseconds < 0 || nanoseconds < 0 {
+		sign = 1
+		milliseconds, nanoseconds = -milliseconds, -nanoseconds
+	}
+	bytes = bin.AppendUvarint(bytes, uint64(milliseconds))
+	if sign != 0 || nanoseconds != 0 {
+		bytes = append(bytes, sign)
+	}
+	if nanoseconds != 0 {
+		bytes = bin.AppendUvarint(bytes, uint64(nanoseconds))
+	}
+	return
+}
+
+func (v *duration_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = formatClass().Payload(DurationType, bytes)
+	if err != nil {
+		return err
+	}
+	var milliseconds, size = bin.Uvarint(payload)
+	if size <= 0 {
+		return fmt.Errorf("The binary payload is not a duration: %x", payload)
+	}
+	var sign byte
+	var nanoseconds uint64
+	if size < len(payload) {
+		// The duration is negative or has sub-millisecond precision.
+		sign = payload[size]
+		size++
+		if size < len(payload) {
+			var rest int
+			nanoseconds, rest = bin.Uvarint(payload[size:])
+			if rest <= 0 || nanoseconds == 0 {
+				return fmt.Errorf("The binary payload is not a duration: %x", payload)
+			}
+			size += rest
+		}
+		var perMillisecond = uint64(durationClass().nanosecondsPerMillisecond_)
+		if sign > 1 || size != len(payload) || nanoseconds >= perMillisecond ||
+			(sign == 0 && nanoseconds == 0) ||
+			(sign == 1 && milliseconds == 0 && nanoseconds == 0) {
+			return fmt.Errorf("The binary payload is not a duration: %x", payload)
+		}
+	}
+	if milliseconds > uint64(mat.MaxInt)+uint64(sign) {
+		// The magnitude of a negative duration may reach -math.MinInt.
+		return fmt.Errorf("The binary payload is not a duration: %x", payload)
+	}
+	var duration = duration_{int(milliseconds), int(nanoseconds)}
+	if sign == 1 {
+		duration = duration_{-duration.milliseconds_, -duration.nanoseconds_}
+	}
+	*v = duration
+	return nil
+}
+
+func (v duration_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	if v.nanoseconds_ != 0 {
+		return cborClass().Encode(
+			durationClass().cborTag_,
+			[]any{v.milliseconds_, v.nanoseconds_},
+		)
+	}
+	return cborClass().Encode(durationClass().cborTag_, v.milliseconds_)
+}
+
+func (v *duration_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = cborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != durationClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not a duration: %x", bytes)
+	}
+	switch actual := value.(type) {
+	case []any:
+		// The duration has sub-millisecond precision.
+		if len(actual) != 2 {
+			return fmt.Errorf("The CBOR data item is not a duration: %x", bytes)
+		}
+		var milliseconds, failure = cborClass().integerFromValue(actual[0])
+		var nanoseconds, problem = cborClass().integerFromValue(actual[1])
+		if failure != nil || problem != nil || nanoseconds == 0 ||
+			!durationClass().isNormalized(milliseconds, nanoseconds) {
+			return fmt.Errorf("The CBOR data item is not a duration: %x", bytes)
+		}
+		*v = duration_{milliseconds, nanoseconds}
+	default:
+		var milliseconds, failure = cborClass().integerFromValue(actual)
+		if failure != nil {
+			return fmt.Errorf("The CBOR data item is not a duration: %x", bytes)
+		}
+		*v = duration_{milliseconds_: milliseconds}
+	}
+	return nil
+}
+
+func (v duration_) Value() (
+	value drv.Value,
+	err error,
+) {
+	if v.nanoseconds_ != 0 {
+		// An integer number of milliseconds would lose the precision.
+		value = v.AsSource()
+		return
+	}
+	value = int64(v.milliseconds_)
+	return
+}
+
+func (v *duration_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case int64:
+		*v = duration_{milliseconds_: int(actual)}
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a duration: %v",
+			source,
+		)
+	}
+}
+
 // Private Methods
 
-func (c *durationClass_) durationFromMatches(matches []string) uint {
+func (c *durationClass_) durationFromMatches(matches []string) duration_ {
 	var milliseconds = 0.0
 	if len(matches[1]) > 0 {
 		// The duration is in weeks.
 		var float, _ = stc.ParseFloat(matches[1], 64)
 		milliseconds += float * float64(c.millisecondsPerWeek_)
-		return uint(milliseconds)
+		return c.signedDuration(matches[0], milliseconds, 0)
 	}
 	if len(matches[2]) > 0 {
 		// The duration has a years component.
@@ -382,12 +783,106 @@ func (c *durationClass_) durationFromMatches(matches []string) uint {
 		var float, _ = stc.ParseFloat(matches[6], 64)
 		milliseconds += float * float64(c.millisecondsPerMinute_)
 	}
+	var nanoseconds int
 	if len(matches[7]) > 0 {
-		// The duration has a seconds component.
-		var float, _ = stc.ParseFloat(matches[7], 64)
-		milliseconds += float * float64(c.millisecondsPerSecond_)
+		// The duration has a seconds component, whose fraction is parsed
+		// exactly down to the nanosecond.
+		var whole, fraction, _ = sts.Cut(matches[7], ".")
+		var seconds, _ = stc.Atoi(whole)
+		milliseconds += float64(seconds) * float64(c.millisecondsPerSecond_)
+		fraction = (fraction + "000000000")[:9]
+		var subseconds, _ = stc.Atoi(fraction)
+		var perMillisecond = int(c.nanosecondsPerMillisecond_)
+		milliseconds += float64(subseconds / perMillisecond)
+		nanoseconds = subseconds % perMillisecond
+	}
+	return c.signedDuration(matches[0], milliseconds, nanoseconds)
+}
+
+// This private method applies the sign of the specified duration source string
+// to the specified number of milliseconds and nanoseconds.
+func (c *durationClass_) signedDuration(
+	source string,
+	milliseconds float64,
+	nanoseconds int,
+) duration_ {
+	var duration = duration_{int(milliseconds), nanoseconds}
+	if sts.HasPrefix(source, "~-") {
+		duration = duration_{-duration.milliseconds_, -duration.nanoseconds_}
 	}
-	return uint(milliseconds)
+	return duration
+}
+
+// This private method returns the concrete duration for the specified duration,
+// retaining any sub-millisecond precision.
+func (c *durationClass_) durationFrom(
+	duration DurationLike,
+) duration_ {
+	var nanoseconds = int(duration.GetMicroseconds()*1000 + duration.GetNanoseconds())
+	if duration.IsNegative() {
+		nanoseconds = -nanoseconds
+	}
+	return duration_{duration.AsIntrinsic(), nanoseconds}
+}
+
+// This private method returns the duration with the specified number of
+// milliseconds and nanoseconds, carrying any whole milliseconds out of the
+// nanoseconds so that the remaining nanoseconds share the sign of the
+// milliseconds.
+func (c *durationClass_) normalized(
+	milliseconds int,
+	nanoseconds int,
+) duration_ {
+	var perMillisecond = int(c.nanosecondsPerMillisecond_)
+	milliseconds += nanoseconds / perMillisecond
+	nanoseconds %= perMillisecond
+	switch {
+	case milliseconds > 0 && nanoseconds < 0:
+		milliseconds--
+		nanoseconds += perMillisecond
+	case milliseconds < 0 && nanoseconds > 0:
+		milliseconds++
+		nanoseconds -= perMillisecond
+	}
+	return duration_{milliseconds, nanoseconds}
+}
+
+// This private method determines whether or not the specified nanoseconds are
+// less than a millisecond and share the sign of the specified milliseconds.
+func (c *durationClass_) isNormalized(
+	milliseconds int,
+	nanoseconds int,
+) bool {
+	var perMillisecond = int(c.nanosecondsPerMillisecond_)
+	return nanoseconds > -perMillisecond && nanoseconds < perMillisecond &&
+		!(milliseconds < 0 && nanoseconds > 0) &&
+		!(milliseconds > 0 && nanoseconds < 0)
+}
+
+// This private method formats the fraction of a second that is made up of the
+// specified milliseconds and nanoseconds (within the millisecond) using three,
+// six or nine digits.
+func (c *durationClass_) formatFraction(
+	milliseconds uint,
+	nanoseconds uint,
+) string {
+	var fraction = fmt.Sprintf("%03d", milliseconds)
+	switch {
+	case nanoseconds == 0:
+	case nanoseconds%1000 == 0:
+		fraction += fmt.Sprintf("%03d", nanoseconds/1000)
+	default:
+		fraction += fmt.Sprintf("%06d", nanoseconds)
+	}
+	return fraction
+}
+
+// This private method returns the magnitude of the duration in milliseconds.
+func (v duration_) magnitude() uint {
+	if v.milliseconds_ < 0 {
+		return uint(-v.milliseconds_)
+	}
+	return uint(v.milliseconds_)
 }
 
 // NOTE:
@@ -403,30 +898,36 @@ const (
 	minutes_  = "(" + timespan_ + ")M"
 	months_   = "(" + timespan_ + ")M"
 	seconds_  = "(" + timespan_ + ")S"
-	timespan_ = "0|" + ordinal_ + "(?:" + fraction_ + ")?"
+	timespan_ = "(?:0|" + ordinal_ + ")(?:" + fraction_ + ")?"
 	weeks_    = "(" + timespan_ + ")W"
 	years_    = "(" + timespan_ + ")Y"
 )
 
 // Instance Structure
 
-type duration_ uint
+type duration_ struct {
+	milliseconds_ int
+	nanoseconds_  int // Within the millisecond with the same sign.
+}
 
 // Class Structure
 
 type durationClass_ struct {
 	// Declare the class constants.
-	matcher_               *reg.Regexp
-	millisecondsPerSecond_ uint
-	millisecondsPerMinute_ uint
-	millisecondsPerHour_   uint
-	millisecondsPerDay_    uint
-	millisecondsPerWeek_   uint
-	millisecondsPerMonth_  uint
-	millisecondsPerYear_   uint
-	daysPerMonth_          float64
-	daysPerYear_           float64
-	weeksPerMonth_         float64
+	matcher_                   *reg.Regexp
+	productions_               map[int]string
+	nanosecondsPerMillisecond_ uint
+	millisecondsPerSecond_     uint
+	millisecondsPerMinute_     uint
+	millisecondsPerHour_       uint
+	millisecondsPerDay_        uint
+	millisecondsPerWeek_       uint
+	millisecondsPerMonth_      uint
+	millisecondsPerYear_       uint
+	daysPerMonth_              float64
+	daysPerYear_               float64
+	weeksPerMonth_             float64
+	cborTag_                   uint64
 }
 
 // Class Reference
@@ -438,17 +939,27 @@ func durationClass() *durationClass_ {
 var durationClassReference_ = &durationClass_{
 	// Initialize the class constants.
 	matcher_: reg.MustCompile(
-		"^~P(?:(?:" + weeks_ + ")|(?:(?:" + years_ + ")?(?:" + months_ +
+		"^~-?P(?:(?:" + weeks_ + ")|(?:(?:" + years_ + ")?(?:" + months_ +
 			")?(?:" + days_ + ")?(?:T(?:" + hours_ + ")?(?:" + minutes_ +
 			")?(?:" + seconds_ + ")?)?))",
 	),
+	productions_: map[int]string{
+		1: "number of weeks",
+		2: "number of years",
+		3: "number of months",
+		4: "number of days",
+		5: "number of hours",
+		6: "number of minutes",
+		7: "number of seconds",
+	},
 
 	// These are locked to the Earth's daily revolutions.
-	millisecondsPerSecond_: 1000,
-	millisecondsPerMinute_: 60000,
-	millisecondsPerHour_:   3600000,
-	millisecondsPerDay_:    86400000,
-	millisecondsPerWeek_:   604800000,
+	nanosecondsPerMillisecond_: 1000000,
+	millisecondsPerSecond_:     1000,
+	millisecondsPerMinute_:     60000,
+	millisecondsPerHour_:       3600000,
+	millisecondsPerDay_:        86400000,
+	millisecondsPerWeek_:       604800000,
 
 	// These are locked to the Earth's yearly orbit around the sun.
 	millisecondsPerMonth_: 2629746000, // An average but exact value.
@@ -458,4 +969,5 @@ var durationClassReference_ = &durationClass_{
 	daysPerMonth_:  30.436875,
 	daysPerYear_:   365.2425,
 	weeksPerMonth_: 4.348125,
+	cborTag_:       0x63646703,
 }
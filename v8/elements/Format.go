@@ -0,0 +1,123 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package elements
+
+import (
+	bin "encoding/binary"
+	fmt "fmt"
+	mat "math"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func FormatClass() FormatClassLike {
+	return formatClass()
+}
+
+// Constructor Methods
+
+// Constant Methods
+
+func (c *formatClass_) Version() uint8 {
+	return c.version_
+}
+
+// Function Methods
+
+func (c *formatClass_) Header(
+	type_ Type,
+) []byte {
+	return []byte{c.version_, byte(type_)}
+}
+
+func (c *formatClass_) Payload(
+	type_ Type,
+	bytes []byte,
+) (
+	payload []byte,
+	err error,
+) {
+	switch {
+	case len(bytes) < 2:
+		err = fmt.Errorf("The binary encoding is missing its header: %x", bytes)
+	case bytes[0] != c.version_:
+		err = fmt.Errorf("The binary encoding has an unsupported version: %v", bytes[0])
+	case Type(bytes[1]) != type_:
+		err = fmt.Errorf(
+			"The binary encoding has the wrong type, expected %v but found %v.",
+			type_,
+			bytes[1],
+		)
+	default:
+		payload = bytes[2:]
+	}
+	return
+}
+
+// INSTANCE INTERFACE
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// This private method appends the specified float to the specified bytes.
+func (c *formatClass_) appendFloat(
+	bytes []byte,
+	float float64,
+) []byte {
+	return bin.BigEndian.AppendUint64(bytes, mat.Float64bits(float))
+}
+
+// This private method extracts the floats from the specified payload, which
+// must contain exactly the specified number of floats.
+func (c *formatClass_) floatsFromPayload(
+	payload []byte,
+	count int,
+) (
+	floats []float64,
+	err error,
+) {
+	if len(payload) != 8*count {
+		err = fmt.Errorf(
+			"The binary payload must contain %v float(s): %x",
+			count,
+			payload,
+		)
+		return
+	}
+	for index := 0; index < count; index++ {
+		var bits = bin.BigEndian.Uint64(payload[8*index:])
+		floats = append(floats, mat.Float64frombits(bits))
+	}
+	return
+}
+
+// Class Structure
+
+type formatClass_ struct {
+	// Declare the class constants.
+	version_ uint8
+}
+
+// Class Reference
+
+func formatClass() *formatClass_ {
+	return formatClassReference_
+}
+
+var formatClassReference_ = &formatClass_{
+	// Initialize the class constants.
+	version_: 1,
+}
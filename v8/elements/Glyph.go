@@ -13,7 +13,10 @@
 package elements
 
 import (
+	drv "database/sql/driver"
+	jsn "encoding/json"
 	fmt "fmt"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	mat "math"
 	reg "regexp"
@@ -46,16 +49,32 @@ func (c *glyphClass_) GlyphFromInteger(
 func (c *glyphClass_) GlyphFromSource(
 	source string,
 ) GlyphLike {
-	var matches = c.matcher_.FindStringSubmatch(source)
+	var glyph, err = c.ParseGlyph(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return glyph
+}
+
+func (c *glyphClass_) ParseGlyph(
+	source string,
+) (
+	glyph GlyphLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
 	if uti.IsUndefined(matches) {
-		var message = fmt.Sprintf(
-			"An illegal string was passed to the glyph constructor method: %s",
+		err = parseErrorClass().ParseErrorFromMatcher(
+			"glyph",
+			c.matcher_,
+			c.productions_,
 			source,
 		)
-		panic(message)
+		return
 	}
 	var rune_, _ = utf.DecodeRuneInString(matches[1]) // Strip off the single quotes.
-	return glyph_(rune_)
+	glyph = glyph_(rune_)
+	return
 }
 
 // Constant Methods
@@ -124,6 +143,134 @@ func (v glyph_) String() string {
 	return v.AsSource()
 }
 
+func (v glyph_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *glyph_) UnmarshalText(
+	text []byte,
+) error {
+	var glyph, err = glyphClass().ParseGlyph(string(text))
+	if err != nil {
+		return err
+	}
+	*v = glyph.(glyph_)
+	return nil
+}
+
+func (v glyph_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *glyph_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v glyph_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(string(v.AsIntrinsic()))
+}
+
+func (v *glyph_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	var text string
+	var err = jsn.Unmarshal(bytes, &text)
+	if err != nil {
+		return err
+	}
+	var runes = []rune(text)
+	if len(runes) != 1 {
+		return fmt.Errorf("A glyph must contain exactly one character: %q", text)
+	}
+	*v = glyph_(runes[0])
+	return nil
+}
+
+func (v glyph_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = formatClass().Header(GlyphType)
+	bytes = append(bytes, v.AsSource()...)
+	return
+}
+
+func (v *glyph_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = formatClass().Payload(GlyphType, bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(payload)
+}
+
+func (v glyph_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	return cborClass().Encode(glyphClass().cborTag_, string(v.AsIntrinsic()))
+}
+
+func (v *glyph_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = cborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != glyphClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not a glyph: %x", bytes)
+	}
+	var text, _ = value.(string)
+	var runes = []rune(text)
+	if len(runes) != 1 {
+		return fmt.Errorf("A glyph must contain exactly one character: %q", text)
+	}
+	*v = glyph_(runes[0])
+	return nil
+}
+
+func (v glyph_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *glyph_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a glyph: %v",
+			source,
+		)
+	}
+}
+
 // Private Methods
 
 // NOTE:
@@ -148,8 +295,10 @@ type glyph_ rune
 
 type glyphClass_ struct {
 	// Declare the class constants.
-	matcher_   *reg.Regexp
-	undefined_ GlyphLike
+	matcher_     *reg.Regexp
+	productions_ map[int]string
+	undefined_   GlyphLike
+	cborTag_     uint64
 }
 
 // Class Reference
@@ -163,5 +312,9 @@ var glyphClassReference_ = &glyphClass_{
 	matcher_: reg.MustCompile(
 		"^'((?:" + escape_ + ")|[^" + control_ + "])'",
 	),
+	productions_: map[int]string{
+		1: "character or escape sequence",
+	},
 	undefined_: glyph_(-1),
+	cborTag_:   0x63646704,
 }
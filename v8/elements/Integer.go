@@ -0,0 +1,642 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package elements
+
+import (
+	drv "database/sql/driver"
+	jsn "encoding/json"
+	fmt "fmt"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	mat "math"
+	big "math/big"
+	bit "math/bits"
+	reg "regexp"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func IntegerClass() IntegerClassLike {
+	return integerClass()
+}
+
+// Constructor Methods
+
+func (c *integerClass_) Integer(
+	integer int,
+) IntegerLike {
+	return c.integerFromBigInt(big.NewInt(int64(integer)))
+}
+
+func (c *integerClass_) IntegerFromBigInt(
+	integer *big.Int,
+) IntegerLike {
+	if integer == nil {
+		panic("An integer cannot be created from a nil big.Int.")
+	}
+	return c.integerFromBigInt(integer)
+}
+
+func (c *integerClass_) IntegerFromSource(
+	source string,
+) IntegerLike {
+	var integer, err = c.ParseInteger(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return integer
+}
+
+func (c *integerClass_) ParseInteger(
+	source string,
+) (
+	integer IntegerLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
+	if uti.IsUndefined(matches) {
+		err = parseErrorClass().ParseErrorFromMatcher(
+			"integer",
+			c.matcher_,
+			c.productions_,
+			source,
+		)
+		return
+	}
+	var magnitude *big.Int
+	for group, base := range c.bases_ {
+		var digits = matches[group+2]
+		if len(digits) > 0 {
+			magnitude = c.magnitudeFromDigits(digits, base)
+			break
+		}
+	}
+	if magnitude == nil {
+		magnitude, _ = new(big.Int).SetString(matches[7], 10)
+	}
+	if matches[1] == "-" {
+		magnitude.Neg(magnitude)
+	}
+	integer = c.integerFromBigInt(magnitude)
+	return
+}
+
+// Constant Methods
+
+func (c *integerClass_) Zero() IntegerLike {
+	return c.zero_
+}
+
+func (c *integerClass_) One() IntegerLike {
+	return c.one_
+}
+
+// Function Methods
+
+func (c *integerClass_) Inverse(
+	integer IntegerLike,
+) IntegerLike {
+	var value = integer.AsBigInt()
+	return c.integerFromBigInt(value.Neg(value))
+}
+
+func (c *integerClass_) Sum(
+	first IntegerLike,
+	second IntegerLike,
+) IntegerLike {
+	var value = new(big.Int).Add(first.AsBigInt(), second.AsBigInt())
+	return c.integerFromBigInt(value)
+}
+
+func (c *integerClass_) Difference(
+	first IntegerLike,
+	second IntegerLike,
+) IntegerLike {
+	var value = new(big.Int).Sub(first.AsBigInt(), second.AsBigInt())
+	return c.integerFromBigInt(value)
+}
+
+func (c *integerClass_) Product(
+	first IntegerLike,
+	second IntegerLike,
+) IntegerLike {
+	var value = new(big.Int).Mul(first.AsBigInt(), second.AsBigInt())
+	return c.integerFromBigInt(value)
+}
+
+func (c *integerClass_) Quotient(
+	first IntegerLike,
+	second IntegerLike,
+) IntegerLike {
+	c.validateDivisor(second)
+	var value = new(big.Int).Quo(first.AsBigInt(), second.AsBigInt())
+	return c.integerFromBigInt(value) // Truncated toward zero.
+}
+
+func (c *integerClass_) Remainder(
+	first IntegerLike,
+	second IntegerLike,
+) IntegerLike {
+	c.validateDivisor(second)
+	var value = new(big.Int).Rem(first.AsBigInt(), second.AsBigInt())
+	return c.integerFromBigInt(value) // Has the sign of the first integer.
+}
+
+func (c *integerClass_) Power(
+	base IntegerLike,
+	exponent uint,
+) IntegerLike {
+	var power = new(big.Int).SetUint64(uint64(exponent))
+	var value = new(big.Int).Exp(base.AsBigInt(), power, nil)
+	return c.integerFromBigInt(value)
+}
+
+func (c *integerClass_) ModularPower(
+	base IntegerLike,
+	exponent IntegerLike,
+	modulus IntegerLike,
+) IntegerLike {
+	if modulus.IsNegative() || modulus.IsZero() {
+		var message = fmt.Sprintf(
+			"The modulus for a modular power must be positive: %v",
+			modulus,
+		)
+		panic(message)
+	}
+	var value = new(big.Int).Exp(
+		base.AsBigInt(),
+		exponent.AsBigInt(),
+		modulus.AsBigInt(),
+	)
+	if value == nil {
+		// A negative exponent requires a modular inverse of the base.
+		var message = fmt.Sprintf(
+			"The base has no inverse modulo %v: %v",
+			modulus,
+			base,
+		)
+		panic(message)
+	}
+	return c.integerFromBigInt(value)
+}
+
+func (c *integerClass_) Gcd(
+	first IntegerLike,
+	second IntegerLike,
+) IntegerLike {
+	var a = first.AsBigInt()
+	var b = second.AsBigInt()
+	var value = new(big.Int).GCD(nil, nil, a.Abs(a), b.Abs(b))
+	return c.integerFromBigInt(value)
+}
+
+func (c *integerClass_) SquareRoot(
+	integer IntegerLike,
+) IntegerLike {
+	if integer.IsNegative() {
+		var message = fmt.Sprintf(
+			"A negative integer has no integer square root: %v",
+			integer,
+		)
+		panic(message)
+	}
+	var value = new(big.Int).Sqrt(integer.AsBigInt()) // Rounded down.
+	return c.integerFromBigInt(value)
+}
+
+func (c *integerClass_) Not(
+	integer IntegerLike,
+) IntegerLike {
+	var value = new(big.Int).Not(integer.AsBigInt())
+	return c.integerFromBigInt(value)
+}
+
+func (c *integerClass_) And(
+	first IntegerLike,
+	second IntegerLike,
+) IntegerLike {
+	var value = new(big.Int).And(first.AsBigInt(), second.AsBigInt())
+	return c.integerFromBigInt(value)
+}
+
+func (c *integerClass_) San(
+	first IntegerLike,
+	second IntegerLike,
+) IntegerLike {
+	var value = new(big.Int).AndNot(first.AsBigInt(), second.AsBigInt())
+	return c.integerFromBigInt(value)
+}
+
+func (c *integerClass_) Ior(
+	first IntegerLike,
+	second IntegerLike,
+) IntegerLike {
+	var value = new(big.Int).Or(first.AsBigInt(), second.AsBigInt())
+	return c.integerFromBigInt(value)
+}
+
+func (c *integerClass_) Xor(
+	first IntegerLike,
+	second IntegerLike,
+) IntegerLike {
+	var value = new(big.Int).Xor(first.AsBigInt(), second.AsBigInt())
+	return c.integerFromBigInt(value)
+}
+
+func (c *integerClass_) ShiftLeft(
+	integer IntegerLike,
+	bits uint,
+) IntegerLike {
+	var value = new(big.Int).Lsh(integer.AsBigInt(), bits)
+	return c.integerFromBigInt(value)
+}
+
+func (c *integerClass_) ShiftRight(
+	integer IntegerLike,
+	bits uint,
+) IntegerLike {
+	var value = new(big.Int).Rsh(integer.AsBigInt(), bits) // Rounded down.
+	return c.integerFromBigInt(value)
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v integer_) GetClass() IntegerClassLike {
+	return integerClass()
+}
+
+func (v integer_) AsBigInt() *big.Int {
+	var integer, _ = new(big.Int).SetString(string(v), 10)
+	return integer
+}
+
+func (v integer_) AsBase(
+	base uint,
+) string {
+	var integer = v.AsBigInt()
+	var source string
+	if integer.Sign() < 0 {
+		source = "-"
+		integer.Neg(integer)
+	}
+	if base == 10 {
+		return source + integer.String() + "n"
+	}
+	var _, ok = integerClass().alphabets_[base]
+	if !ok {
+		var message = fmt.Sprintf(
+			"An integer can only be formatted in base 2, 8, 10, 16, 32 or 64: %v",
+			base,
+		)
+		panic(message)
+	}
+	source += fmt.Sprintf("%dr", base)
+	source += integerClass().digitsFromMagnitude(integer, base)
+	return source + "n"
+}
+
+func (v integer_) AsCheckedInteger() (
+	integer int,
+	ok bool,
+) {
+	var value = v.AsBigInt()
+	if !value.IsInt64() || value.Int64() < mat.MinInt || value.Int64() > mat.MaxInt {
+		return
+	}
+	integer = int(value.Int64())
+	ok = true
+	return
+}
+
+// Attribute Methods
+
+// Discrete Methods
+
+func (v integer_) AsSource() string {
+	return string(v) + "n"
+}
+
+func (v integer_) AsInteger() int {
+	var integer, ok = v.AsCheckedInteger()
+	switch {
+	case ok:
+		return integer
+	case v.IsNegative():
+		return mat.MinInt
+	default:
+		return mat.MaxInt
+	}
+}
+
+func (v integer_) IsDefined() bool {
+	return true
+}
+
+func (v integer_) IsMinimum() bool {
+	return false
+}
+
+func (v integer_) IsZero() bool {
+	return v == integerClass().zero_
+}
+
+func (v integer_) IsMaximum() bool {
+	return false
+}
+
+// Polarized Methods
+
+func (v integer_) IsNegative() bool {
+	return sts.HasPrefix(string(v), "-")
+}
+
+// PROTECTED INTERFACE
+
+func (v integer_) String() string {
+	return v.AsSource()
+}
+
+func (v integer_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *integer_) UnmarshalText(
+	text []byte,
+) error {
+	var integer, err = integerClass().ParseInteger(string(text))
+	if err != nil {
+		return err
+	}
+	*v = integer.(integer_)
+	return nil
+}
+
+func (v integer_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *integer_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v integer_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	// A JSON number preserves every digit of the integer.
+	return jsn.Marshal(jsn.Number(v))
+}
+
+func (v *integer_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	if !jsonClass().isString(bytes) {
+		var number jsn.Number
+		var err = jsn.Unmarshal(bytes, &number)
+		if err != nil {
+			return err
+		}
+		var source = string(number) + "n"
+		if integerClass().matcher_.FindString(source) != source {
+			return fmt.Errorf("The JSON value is not an integer: %s", bytes)
+		}
+		return v.UnmarshalText([]byte(source))
+	}
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v integer_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = formatClass().Header(IntegerType)
+	bytes = append(bytes, v.AsSource()...)
+	return
+}
+
+func (v *integer_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = formatClass().Payload(IntegerType, bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(payload)
+}
+
+func (v integer_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	var integer = v.AsBigInt()
+	if integer.Sign() < 0 {
+		// A negative bignum encodes -1 - n.
+		integer.Not(integer)
+		return cborClass().Encode(integerClass().negativeTag_, integer.Bytes())
+	}
+	return cborClass().Encode(integerClass().positiveTag_, integer.Bytes())
+}
+
+func (v *integer_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = cborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	var magnitude, ok = value.([]byte)
+	if !ok || (tag != integerClass().positiveTag_ && tag != integerClass().negativeTag_) {
+		return fmt.Errorf("The CBOR data item is not an integer: %x", bytes)
+	}
+	var integer = new(big.Int).SetBytes(magnitude)
+	if tag == integerClass().negativeTag_ {
+		integer.Not(integer)
+	}
+	*v = integerClass().integerFromBigInt(integer)
+	return nil
+}
+
+func (v integer_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *integer_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into an integer: %v",
+			source,
+		)
+	}
+}
+
+// Private Methods
+
+// This private method returns the digits of the specified non-negative
+// magnitude in the specified power of two base, taking the bits of each digit
+// from the magnitude directly.
+func (c *integerClass_) digitsFromMagnitude(
+	magnitude *big.Int,
+	base uint,
+) string {
+	var alphabet = c.alphabets_[base]
+	var width = bit.TrailingZeros(base) // The number of bits per digit.
+	var count = max((magnitude.BitLen()+width-1)/width, 1)
+	var digits = make([]byte, count)
+	for index := range count {
+		var digit uint
+		for offset := range width {
+			digit |= magnitude.Bit(index*width+offset) << offset
+		}
+		digits[count-index-1] = alphabet[digit]
+	}
+	return string(digits)
+}
+
+func (c *integerClass_) integerFromBigInt(
+	integer *big.Int,
+) integer_ {
+	return integer_(integer.String()) // There is no negative zero.
+}
+
+// This private method returns the magnitude for the specified digits in the
+// specified power of two base.  The digits have already been validated by the
+// matcher.
+func (c *integerClass_) magnitudeFromDigits(
+	digits string,
+	base uint,
+) *big.Int {
+	if base == 16 {
+		digits = sts.ToUpper(digits)
+	}
+	var alphabet = c.alphabets_[base]
+	var width = uint(bit.TrailingZeros(base))
+	var magnitude = new(big.Int)
+	for _, digit := range []byte(digits) {
+		magnitude.Lsh(magnitude, width)
+		magnitude.Or(magnitude, big.NewInt(int64(sts.IndexByte(alphabet, digit))))
+	}
+	return magnitude
+}
+
+func (c *integerClass_) validateDivisor(
+	divisor IntegerLike,
+) {
+	if divisor.IsZero() {
+		panic("An integer cannot be divided by zero.")
+	}
+}
+
+// NOTE:
+// These private constants are used to define the private regular expression
+// matcher that is used to match legal string patterns for this intrinsic type.
+// Unfortunately there is no way to make them private to this class since they
+// must be TRUE Go constants to be used in this way.  We append an underscore to
+// each name to lessen the chance of a name collision with other private Go
+// class constants in this package.
+const (
+	radix16_ = "[0-9A-Fa-f]"
+	radix2_  = "[01]"
+	radix32_ = "[0-9A-DF-HJ-NP-TV-Z]"
+	radix64_ = "[A-Za-z0-9+/]"
+	radix8_  = "[0-7]"
+)
+
+// Instance Structure
+
+type integer_ string // The canonical decimal digits without the "n" suffix.
+
+// Class Structure
+
+type integerClass_ struct {
+	// Declare the class constants.
+	matcher_     *reg.Regexp
+	productions_ map[int]string
+	bases_       []uint
+	alphabets_   map[uint]string
+	zero_        integer_
+	one_         integer_
+	positiveTag_ uint64
+	negativeTag_ uint64
+}
+
+// Class Reference
+
+func integerClass() *integerClass_ {
+	return integerClassReference_
+}
+
+// The integer matcher captures the sign in group 1, the digits for each of the
+// bases in the bases_ list in groups 2 through 6, and the decimal digits in
+// group 7.
+var integerClassReference_ = &integerClass_{
+	// Initialize the class constants.
+	matcher_: reg.MustCompile(
+		"^(" + sign_ + ")?(?:2r((?:" + radix2_ + ")+)|8r((?:" + radix8_ +
+			")+)|16r((?:" + radix16_ + ")+)|32r((?:" + radix32_ +
+			")+)|64r((?:" + radix64_ + ")+)|(0|" + ordinal_ + "))n",
+	),
+	productions_: map[int]string{
+		1: "sign",
+		2: "base 2 digits",
+		3: "base 8 digits",
+		4: "base 16 digits",
+		5: "base 32 digits",
+		6: "base 64 digits",
+		7: "decimal digits",
+	},
+	bases_: []uint{2, 8, 16, 32, 64},
+	alphabets_: map[uint]string{
+		2:  "01",
+		8:  "01234567",
+		16: "0123456789ABCDEF",
+		32: "0123456789ABCDFGHJKLMNPQRSTVWXYZ",
+		64: "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/",
+	},
+	zero_:        integer_("0"),
+	one_:         integer_("1"),
+	positiveTag_: 2,
+	negativeTag_: 3,
+}
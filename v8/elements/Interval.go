@@ -0,0 +1,594 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package elements
+
+import (
+	drv "database/sql/driver"
+	bin "encoding/binary"
+	jsn "encoding/json"
+	fmt "fmt"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	mat "math"
+	bit "math/bits"
+	reg "regexp"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func IntervalClass() IntervalClassLike {
+	return intervalClass()
+}
+
+// Constructor Methods
+
+func (c *intervalClass_) Interval(
+	start MomentLike,
+	end MomentLike,
+) IntervalLike {
+	c.validateBounds(start.AsIntrinsic(), end.AsIntrinsic())
+	return interval_{start.AsIntrinsic(), end.AsIntrinsic()}
+}
+
+func (c *intervalClass_) IntervalFromDuration(
+	start MomentLike,
+	duration DurationLike,
+) IntervalLike {
+	var end = momentClass().Later(start, duration)
+	return c.Interval(start, end)
+}
+
+func (c *intervalClass_) IntervalFromSource(
+	source string,
+) IntervalLike {
+	var interval, err = c.ParseInterval(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return interval
+}
+
+func (c *intervalClass_) ParseInterval(
+	source string,
+) (
+	interval IntervalLike,
+	err error,
+) {
+	var indices = gra.MatchSourceIndex(c.matcher_, source)
+	if uti.IsUndefined(indices) && sts.HasPrefix(source, "<") &&
+		!momentClass().matcher_.MatchString(source) {
+		// The start moment is malformed so the moment class reports the error.
+		_, err = momentClass().ParseMoment(source)
+		return
+	}
+	if uti.IsUndefined(indices) {
+		err = parseErrorClass().ParseErrorFromMatcher(
+			"interval",
+			c.matcher_,
+			c.productions_,
+			source,
+		)
+		return
+	}
+	var start, end MomentLike
+	start, err = momentClass().ParseMoment(source[indices[2]:indices[3]])
+	if err != nil {
+		return
+	}
+	var group = c.momentGroups_ + 2
+	if indices[2*group] >= 0 {
+		end, err = momentClass().ParseMoment(
+			source[indices[2*group]:indices[2*group+1]],
+		)
+	} else {
+		group = 2*c.momentGroups_ + 3
+		var duration DurationLike
+		duration, err = durationClass().ParseDuration(
+			source[indices[2*group]:indices[2*group+1]],
+		)
+		end = momentClass().Later(start, duration)
+	}
+	if err != nil {
+		return
+	}
+	if end.AsIntrinsic() < start.AsIntrinsic() {
+		err = parseErrorClass().ParseError(
+			"interval",
+			source,
+			uint(indices[2*group]),
+			"an end that is not before the start",
+		)
+		return
+	}
+	interval = interval_{start.AsIntrinsic(), end.AsIntrinsic()}
+	return
+}
+
+// Constant Methods
+
+// Function Methods
+
+func (c *intervalClass_) Contains(
+	interval IntervalLike,
+	moment MomentLike,
+) bool {
+	var bounds = interval.AsIntrinsic()
+	var milliseconds = moment.AsIntrinsic()
+	return bounds[0] <= milliseconds && milliseconds < bounds[1]
+}
+
+func (c *intervalClass_) Overlaps(
+	first IntervalLike,
+	second IntervalLike,
+) bool {
+	var firstBounds = first.AsIntrinsic()
+	var secondBounds = second.AsIntrinsic()
+	return firstBounds[0] < secondBounds[1] && secondBounds[0] < firstBounds[1]
+}
+
+func (c *intervalClass_) Intersection(
+	first IntervalLike,
+	second IntervalLike,
+) (
+	intersection IntervalLike,
+	ok bool,
+) {
+	if !c.Overlaps(first, second) {
+		return
+	}
+	var firstBounds = first.AsIntrinsic()
+	var secondBounds = second.AsIntrinsic()
+	intersection = interval_{
+		max(firstBounds[0], secondBounds[0]),
+		min(firstBounds[1], secondBounds[1]),
+	}
+	ok = true
+	return
+}
+
+func (c *intervalClass_) Union(
+	first IntervalLike,
+	second IntervalLike,
+) (
+	union IntervalLike,
+	ok bool,
+) {
+	var firstBounds = first.AsIntrinsic()
+	var secondBounds = second.AsIntrinsic()
+	if firstBounds[0] > secondBounds[1] || secondBounds[0] > firstBounds[1] {
+		// There is a gap between the intervals.
+		return
+	}
+	union = interval_{
+		min(firstBounds[0], secondBounds[0]),
+		max(firstBounds[1], secondBounds[1]),
+	}
+	ok = true
+	return
+}
+
+func (c *intervalClass_) Gap(
+	first IntervalLike,
+	second IntervalLike,
+) (
+	gap IntervalLike,
+	ok bool,
+) {
+	var firstBounds = first.AsIntrinsic()
+	var secondBounds = second.AsIntrinsic()
+	switch {
+	case firstBounds[1] < secondBounds[0]:
+		gap = interval_{firstBounds[1], secondBounds[0]}
+		ok = true
+	case secondBounds[1] < firstBounds[0]:
+		gap = interval_{secondBounds[1], firstBounds[0]}
+		ok = true
+	default:
+		// The intervals overlap or abut each other.
+	}
+	return
+}
+
+func (c *intervalClass_) Split(
+	interval IntervalLike,
+	duration DurationLike,
+) []IntervalLike {
+	var iterator = c.subintervals(interval, duration)
+	var subintervals []IntervalLike
+	for iterator.HasNext() {
+		subintervals = append(subintervals, iterator.GetNext())
+	}
+	return subintervals
+}
+
+func (c *intervalClass_) Subintervals(
+	interval IntervalLike,
+	duration DurationLike,
+) uti.Ratcheted[IntervalLike] {
+	return c.subintervals(interval, duration)
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v interval_) GetClass() IntervalClassLike {
+	return intervalClass()
+}
+
+func (v interval_) AsIntrinsic() [2]int {
+	return [2]int(v)
+}
+
+func (v interval_) AsSource() string {
+	return v.GetStart().AsSource() + ".." + v.GetEnd().AsSource()
+}
+
+func (v interval_) GetStart() MomentLike {
+	return moment_{milliseconds_: v[0]}
+}
+
+func (v interval_) GetEnd() MomentLike {
+	return moment_{milliseconds_: v[1]}
+}
+
+func (v interval_) GetDuration() DurationLike {
+	return duration_{milliseconds_: v[1] - v[0]}
+}
+
+func (v interval_) IsEmpty() bool {
+	return v[0] == v[1]
+}
+
+// Attribute Methods
+
+// PROTECTED INTERFACE
+
+func (v interval_) String() string {
+	return v.AsSource()
+}
+
+func (v interval_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *interval_) UnmarshalText(
+	text []byte,
+) error {
+	var interval, err = intervalClass().ParseInterval(string(text))
+	if err != nil {
+		return err
+	}
+	*v = interval.(interval_)
+	return nil
+}
+
+func (v interval_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *interval_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v interval_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsIntrinsic())
+}
+
+func (v *interval_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	var bounds []int
+	var err = jsn.Unmarshal(bytes, &bounds)
+	if err != nil {
+		return err
+	}
+	if len(bounds) != 2 || bounds[1] < bounds[0] {
+		return fmt.Errorf("The JSON value is not an interval: %s", bytes)
+	}
+	*v = interval_{bounds[0], bounds[1]}
+	return nil
+}
+
+func (v interval_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = formatClass().Header(IntervalType)
+	bytes = bin.AppendVarint(bytes, int64(v[0]))
+	bytes = bin.AppendVarint(bytes, int64(v[1]))
+	return
+}
+
+func (v *interval_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = formatClass().Payload(IntervalType, bytes)
+	if err != nil {
+		return err
+	}
+	var start, size = bin.Varint(payload)
+	if size <= 0 {
+		return fmt.Errorf("The binary payload is not an interval: %x", payload)
+	}
+	var end, rest = bin.Varint(payload[size:])
+	if rest <= 0 || size+rest != len(payload) || end < start {
+		return fmt.Errorf("The binary payload is not an interval: %x", payload)
+	}
+	*v = interval_{int(start), int(end)}
+	return nil
+}
+
+func (v interval_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	return cborClass().Encode(intervalClass().cborTag_, []any{v[0], v[1]})
+}
+
+func (v *interval_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = cborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != intervalClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not an interval: %x", bytes)
+	}
+	var items, _ = value.([]any)
+	if len(items) != 2 {
+		return fmt.Errorf("The CBOR data item is not an interval: %x", bytes)
+	}
+	var bounds [2]int
+	for index, item := range items {
+		switch milliseconds := item.(type) {
+		case uint64:
+			bounds[index] = int(milliseconds)
+		case int64:
+			bounds[index] = int(milliseconds)
+		default:
+			return fmt.Errorf("The CBOR data item is not an interval: %x", bytes)
+		}
+	}
+	if bounds[1] < bounds[0] {
+		return fmt.Errorf("The interval ends before it starts: %v", bounds)
+	}
+	*v = interval_(bounds)
+	return nil
+}
+
+func (v interval_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *interval_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into an interval: %v",
+			source,
+		)
+	}
+}
+
+// Private Methods
+
+func (c *intervalClass_) validateBounds(
+	start int,
+	end int,
+) {
+	if end < start {
+		var message = fmt.Sprintf(
+			"An interval cannot end before it starts: %v..%v",
+			moment_{milliseconds_: start},
+			moment_{milliseconds_: end},
+		)
+		panic(message)
+	}
+}
+
+// This private method returns an iterator over the consecutive subintervals of
+// the specified interval that have the specified duration.  The subintervals
+// are calculated on demand rather than up front.
+func (c *intervalClass_) subintervals(
+	interval IntervalLike,
+	duration DurationLike,
+) *subintervals_ {
+	if duration.IsZero() || duration.IsNegative() {
+		var message = fmt.Sprintf(
+			"An interval can only be split by a positive duration: %v",
+			duration,
+		)
+		panic(message)
+	}
+	if duration.AsIntrinsic() == 0 {
+		// The bounds of an interval have a resolution of one millisecond.
+		var message = fmt.Sprintf(
+			"An interval can only be split by a duration of at least one millisecond: %v",
+			duration,
+		)
+		panic(message)
+	}
+	var bounds = interval.AsIntrinsic()
+	var iterator = &subintervals_{
+		start_:    bounds[0],
+		end_:      bounds[1],
+		step_:     duration.AsIntrinsic(),
+		fraction_: uint64(duration.GetMicroseconds()*1000 + duration.GetNanoseconds()),
+	}
+	var estimate = float64(bounds[1]-bounds[0]) / duration.AsMilliseconds()
+	var size = uint(mat.Ceil(estimate))
+	for size > 0 && iterator.boundary(size-1) >= bounds[1] {
+		size--
+	}
+	for iterator.boundary(size) < bounds[1] {
+		size++
+	}
+	iterator.size_ = size
+	return iterator
+}
+
+// This private method returns the start of the subinterval in the specified
+// slot.  Any sub-millisecond part of the step accumulates across the slots so
+// the bounds only drift by less than a millisecond.
+func (v *subintervals_) boundary(
+	slot uint,
+) int {
+	var high, low = bit.Mul64(uint64(slot), v.fraction_)
+	var carry, _ = bit.Div64(
+		high,
+		low,
+		uint64(durationClass().nanosecondsPerMillisecond_),
+	)
+	return v.start_ + int(slot)*v.step_ + int(carry)
+}
+
+func (v *subintervals_) subinterval(
+	slot uint,
+) IntervalLike {
+	var end = min(v.boundary(slot+1), v.end_)
+	return interval_{v.boundary(slot), end}
+}
+
+func (v *subintervals_) ToStart() {
+	v.slot_ = 0
+}
+
+func (v *subintervals_) ToEnd() {
+	v.slot_ = v.size_
+}
+
+func (v *subintervals_) IsEmpty() bool {
+	return v.size_ == 0
+}
+
+func (v *subintervals_) GetSize() uint {
+	return v.size_
+}
+
+func (v *subintervals_) GetSlot() uint {
+	return v.slot_
+}
+
+func (v *subintervals_) SetSlot(
+	slot uint,
+) {
+	v.slot_ = min(slot, v.size_)
+}
+
+func (v *subintervals_) HasPrevious() bool {
+	return v.slot_ > 0
+}
+
+func (v *subintervals_) GetPrevious() IntervalLike {
+	if v.slot_ == 0 {
+		return nil
+	}
+	v.slot_--
+	return v.subinterval(v.slot_)
+}
+
+func (v *subintervals_) HasNext() bool {
+	return v.slot_ < v.size_
+}
+
+func (v *subintervals_) GetNext() IntervalLike {
+	if v.slot_ == v.size_ {
+		return nil
+	}
+	v.slot_++
+	return v.subinterval(v.slot_ - 1)
+}
+
+// Instance Structure
+
+type interval_ [2]int // The start and end milliseconds of a half-open interval.
+
+// This private type is an iterator over the subintervals of an interval.  The
+// step of each subinterval is split into its milliseconds and the nanoseconds
+// within the last millisecond.
+type subintervals_ struct {
+	start_    int
+	end_      int
+	step_     int
+	fraction_ uint64
+	size_     uint
+	slot_     uint
+}
+
+// Class Structure
+
+type intervalClass_ struct {
+	// Declare the class constants.
+	matcher_      *reg.Regexp
+	productions_  map[int]string
+	momentGroups_ int
+	cborTag_      uint64
+}
+
+// Class Reference
+
+func intervalClass() *intervalClass_ {
+	return intervalClassReference_
+}
+
+// The interval matcher is composed from the moment and duration matchers (minus
+// their leading "^" anchors) so its capture groups are numbered as follows:
+//   - 1: the start moment
+//   - momentGroups_ + 2: the end moment
+//   - 2 * momentGroups_ + 3: the duration
+var intervalClassReference_ = &intervalClass_{
+	// Initialize the class constants.
+	matcher_: reg.MustCompile(
+		"^(" + momentClass().matcher_.String()[1:] + ")(?:\\.\\.(" +
+			momentClass().matcher_.String()[1:] + ")|/(" +
+			durationClass().matcher_.String()[1:] + "))",
+	),
+	productions_: map[int]string{
+		1:                                        "start moment",
+		momentClass().matcher_.NumSubexp() + 2:   "end moment",
+		2*momentClass().matcher_.NumSubexp() + 3: "duration",
+	},
+	momentGroups_: momentClass().matcher_.NumSubexp(),
+	cborTag_:      0x6364670a,
+}
@@ -0,0 +1,141 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package elements
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func JsonClass() JsonClassLike {
+	return jsonClass()
+}
+
+// Constructor Methods
+
+// Constant Methods
+
+// Function Methods
+
+func (c *jsonClass_) Marshal(
+	primitive any,
+	encoding Encoding,
+) (
+	bytes []byte,
+	err error,
+) {
+	switch encoding {
+	case SourceEncoding:
+		bytes, err = jsn.Marshal(primitive)
+	case NativeEncoding:
+		var marshaler, ok = primitive.(nativeMarshaler_)
+		if !ok {
+			// The primitive has no native form so its source form is used.
+			bytes, err = jsn.Marshal(primitive)
+			return
+		}
+		bytes, err = marshaler.MarshalNativeJSON()
+	default:
+		err = fmt.Errorf("An invalid JSON encoding was specified: %v", encoding)
+	}
+	return
+}
+
+func (c *jsonClass_) Unmarshal(
+	bytes []byte,
+	encoding Encoding,
+	primitive any,
+) (
+	err error,
+) {
+	switch encoding {
+	case SourceEncoding:
+		err = jsn.Unmarshal(bytes, primitive)
+	case NativeEncoding:
+		var unmarshaler, ok = primitive.(nativeUnmarshaler_)
+		if !ok {
+			// The primitive has no native form so its source form is used.
+			err = jsn.Unmarshal(bytes, primitive)
+			return
+		}
+		err = unmarshaler.UnmarshalNativeJSON(bytes)
+	default:
+		err = fmt.Errorf("An invalid JSON encoding was specified: %v", encoding)
+	}
+	return
+}
+
+// INSTANCE INTERFACE
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// This private method determines whether or not the specified JSON value is a
+// JSON string.  It is used to recognize values that are encoded using their
+// source form in the native encoding.
+func (c *jsonClass_) isString(
+	bytes []byte,
+) bool {
+	var token any
+	var err = jsn.Unmarshal(bytes, &token)
+	if err != nil {
+		return false
+	}
+	var _, ok = token.(string)
+	return ok
+}
+
+// This private method extracts the source form of a primitive from the
+// specified JSON string.
+func (c *jsonClass_) sourceFromJson(
+	bytes []byte,
+) (
+	source []byte,
+	err error,
+) {
+	var text string
+	err = jsn.Unmarshal(bytes, &text)
+	source = []byte(text)
+	return
+}
+
+// These private interfaces are implemented by every primitive class that has a
+// native JSON encoding.
+type nativeMarshaler_ interface {
+	MarshalNativeJSON() ([]byte, error)
+}
+
+type nativeUnmarshaler_ interface {
+	UnmarshalNativeJSON(bytes []byte) error
+}
+
+// Class Structure
+
+type jsonClass_ struct {
+	// Declare the class variables.
+}
+
+// Class Reference
+
+func jsonClass() *jsonClass_ {
+	return jsonClassReference_
+}
+
+var jsonClassReference_ = &jsonClass_{
+	// Initialize the class variables.
+}
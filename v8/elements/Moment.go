@@ -13,13 +13,19 @@
 package elements
 
 import (
+	cmp "cmp"
+	drv "database/sql/driver"
+	bin "encoding/binary"
+	jsn "encoding/json"
 	fmt "fmt"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	mat "math"
 	reg "regexp"
 	stc "strconv"
 	sts "strings"
 	tim "time"
+	_ "time/tzdata" // Embeds the IANA time zone database.
 )
 
 // CLASS INTERFACE
@@ -35,21 +41,84 @@ func MomentClass() MomentClassLike {
 func (c *momentClass_) Moment(
 	milliseconds int,
 ) MomentLike {
-	return moment_(milliseconds)
+	return moment_{milliseconds_: milliseconds}
+}
+
+func (c *momentClass_) MomentWithNanoseconds(
+	milliseconds int,
+	nanoseconds int,
+) MomentLike {
+	var perMillisecond = int(durationClass().nanosecondsPerMillisecond_)
+	if nanoseconds < 0 || nanoseconds >= perMillisecond {
+		var message = fmt.Sprintf(
+			"The nanoseconds must be a non-negative part of a millisecond: %v",
+			nanoseconds,
+		)
+		panic(message)
+	}
+	return moment_{milliseconds, nanoseconds}
+}
+
+func (c *momentClass_) MomentFromTime(
+	time tim.Time,
+) MomentLike {
+	return c.momentFromTime(time)
 }
 
 func (c *momentClass_) MomentFromSource(
 	source string,
 ) MomentLike {
-	var matches = c.matcher_.FindStringSubmatch(source)
+	var moment, err = c.ParseMoment(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return moment
+}
+
+func (c *momentClass_) ParseMoment(
+	source string,
+) (
+	moment MomentLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
 	if uti.IsUndefined(matches) {
-		var message = fmt.Sprintf(
-			"An illegal string was passed to the moment constructor method: %s",
+		err = parseErrorClass().ParseErrorFromMatcher(
+			"moment",
+			c.formOf(source),
+			c.productions_,
 			source,
 		)
-		panic(message)
+		return
+	}
+	var time, ok = c.timeFromMatches(matches)
+	if ok && len(matches[13]) > 0 {
+		var location, failure = c.locationFromZone(sts.Trim(matches[13], "[]"))
+		if failure != nil {
+			var indices = c.matcher_.FindStringSubmatchIndex(source)
+			err = parseErrorClass().ParseError(
+				"moment",
+				source,
+				uint(indices[26]),
+				"a known time zone",
+			)
+			return
+		}
+		time = c.timeInLocation(time, location)
 	}
-	return moment_(c.momentFromMatches(matches))
+	if !ok {
+		// The moment matches the grammar but is not on the calendar.
+		var indices = c.matcher_.FindStringSubmatchIndex(source)
+		err = parseErrorClass().ParseError(
+			"moment",
+			source,
+			uint(indices[2]),
+			"a date-time that exists on the calendar",
+		)
+		return
+	}
+	moment = c.momentFromTime(time)
+	return
 }
 
 // Constant Methods
@@ -62,32 +131,194 @@ func (c *momentClass_) Epoch() MomentLike {
 
 func (c *momentClass_) Now() MomentLike {
 	var now = tim.Now().UTC().UnixMilli()
-	return moment_(now)
+	return moment_{milliseconds_: int(now)}
 }
 
 func (c *momentClass_) Earlier(
 	moment MomentLike,
 	duration DurationLike,
 ) MomentLike {
-	return moment_(moment.AsInteger() - duration.AsInteger())
+	var first = c.momentFrom(moment)
+	var second = durationClass().durationFrom(duration)
+	return c.normalized(
+		first.milliseconds_-second.milliseconds_,
+		first.nanoseconds_-second.nanoseconds_,
+	)
 }
 
 func (c *momentClass_) Later(
 	moment MomentLike,
 	duration DurationLike,
 ) MomentLike {
-	return moment_(moment.AsInteger() + duration.AsInteger())
+	var first = c.momentFrom(moment)
+	var second = durationClass().durationFrom(duration)
+	return c.normalized(
+		first.milliseconds_+second.milliseconds_,
+		first.nanoseconds_+second.nanoseconds_,
+	)
 }
 
 func (c *momentClass_) Duration(
 	first MomentLike,
 	second MomentLike,
 ) DurationLike {
-	var delta = second.AsInteger() - first.AsInteger()
-	if delta < 0 {
-		delta = -delta
+	var offset = c.Offset(first, second)
+	if offset.IsNegative() {
+		return c.Offset(second, first)
+	}
+	return offset
+}
+
+func (c *momentClass_) Offset(
+	first MomentLike,
+	second MomentLike,
+) DurationLike {
+	var start = c.momentFrom(first)
+	var end = c.momentFrom(second)
+	return durationClass().normalized(
+		end.milliseconds_-start.milliseconds_,
+		end.nanoseconds_-start.nanoseconds_,
+	)
+}
+
+func (c *momentClass_) FactorsInZone(
+	moment MomentLike,
+	zone string,
+) (
+	factors Factored,
+	err error,
+) {
+	var location, failure = c.locationFromZone(zone)
+	if failure != nil {
+		err = failure
+		return
 	}
-	return durationClass().Duration(uint(delta))
+	var time = c.momentFrom(moment).asTime().In(location)
+	factors = factors_{time}
+	return
+}
+
+func (c *momentClass_) AddYears(
+	moment MomentLike,
+	years int,
+) MomentLike {
+	return c.AddMonths(moment, years*12)
+}
+
+func (c *momentClass_) AddMonths(
+	moment MomentLike,
+	months int,
+) MomentLike {
+	var time = c.momentFrom(moment).asTime()
+	var year, month, day = time.Date()
+	var first = tim.Date(
+		year,
+		month+tim.Month(months),
+		1,
+		time.Hour(),
+		time.Minute(),
+		time.Second(),
+		time.Nanosecond(),
+		tim.UTC,
+	)
+	var last = first.AddDate(0, 1, -1).Day()
+	if day > last {
+		// Clamp the day to the end of the month.
+		day = last
+	}
+	time = first.AddDate(0, 0, day-1)
+	return c.momentFromTime(time)
+}
+
+func (c *momentClass_) AddWeeks(
+	moment MomentLike,
+	weeks int,
+) MomentLike {
+	return c.AddDays(moment, weeks*7)
+}
+
+func (c *momentClass_) AddDays(
+	moment MomentLike,
+	days int,
+) MomentLike {
+	var time = c.momentFrom(moment).asTime()
+	time = time.AddDate(0, 0, days)
+	return c.momentFromTime(time)
+}
+
+func (c *momentClass_) Truncated(
+	moment MomentLike,
+	period Period,
+) MomentLike {
+	var time = c.momentFrom(moment).asTime()
+	var year, month, day = time.Date()
+	switch period {
+	case DayPeriod:
+	case WeekPeriod:
+		// ISO 8601 weeks start on Monday.
+		day -= (int(time.Weekday()) + 6) % 7
+	case MonthPeriod:
+		day = 1
+	case QuarterPeriod:
+		month = (month-1)/3*3 + 1
+		day = 1
+	case YearPeriod:
+		month = tim.January
+		day = 1
+	default:
+		panic("An invalid calendar period was specified.")
+	}
+	time = tim.Date(year, month, day, 0, 0, 0, 0, tim.UTC)
+	return c.momentFromTime(time)
+}
+
+func (c *momentClass_) Rounded(
+	moment MomentLike,
+	period Period,
+) MomentLike {
+	var start = c.Truncated(moment, period)
+	var next MomentLike
+	switch period {
+	case DayPeriod:
+		next = c.AddDays(start, 1)
+	case WeekPeriod:
+		next = c.AddWeeks(start, 1)
+	case MonthPeriod:
+		next = c.AddMonths(start, 1)
+	case QuarterPeriod:
+		next = c.AddMonths(start, 3)
+	case YearPeriod:
+		next = c.AddYears(start, 1)
+	}
+	var before = c.Offset(start, moment)
+	var after = c.Offset(moment, next)
+	if durationClass().Compare(before, after) < 0 {
+		return start
+	}
+	// Halfway moments are rounded up.
+	return next
+}
+
+func (c *momentClass_) Compare(
+	first MomentLike,
+	second MomentLike,
+) int {
+	var left = c.momentFrom(first)
+	var right = c.momentFrom(second)
+	var comparison = cmp.Compare(left.milliseconds_, right.milliseconds_)
+	if comparison == 0 {
+		comparison = cmp.Compare(left.nanoseconds_, right.nanoseconds_)
+	}
+	return comparison
+}
+
+func (c *momentClass_) ApproximatelyEqual(
+	first MomentLike,
+	second MomentLike,
+	tolerance DurationLike,
+) bool {
+	var duration = c.Duration(first, second)
+	return durationClass().Compare(duration, tolerance) <= 0
 }
 
 // INSTANCE INTERFACE
@@ -99,7 +330,59 @@ func (v moment_) GetClass() MomentClassLike {
 }
 
 func (v moment_) AsIntrinsic() int {
-	return int(v)
+	return v.milliseconds_
+}
+
+func (v moment_) AsTime() tim.Time {
+	return v.asTime()
+}
+
+func (v moment_) AsWeekDate() string {
+	var builder sts.Builder
+	var year = v.GetWeekYear()
+	builder.WriteString("<")
+	if year < 0 {
+		builder.WriteString("-")
+		year = -year
+	}
+	builder.WriteString(momentClass().formatOrdinal(uint(year), 0))
+	builder.WriteString("-W")
+	var _, week = v.asTime().ISOWeek()
+	builder.WriteString(momentClass().formatOrdinal(uint(week), 2))
+	builder.WriteString("-")
+	builder.WriteString(momentClass().formatOrdinal(v.GetWeekday(), 0))
+	builder.WriteString(v.formatTime())
+	builder.WriteString(">")
+	return builder.String()
+}
+
+func (v moment_) AsOrdinalDate() string {
+	var builder sts.Builder
+	builder.WriteString("<")
+	if v.IsNegative() {
+		builder.WriteString("-")
+	}
+	builder.WriteString(momentClass().formatOrdinal(v.GetYears(), 0))
+	builder.WriteString("-")
+	builder.WriteString(momentClass().formatOrdinal(v.GetDayOfYear(), 3))
+	builder.WriteString(v.formatTime())
+	builder.WriteString(">")
+	return builder.String()
+}
+
+func (v moment_) GetWeekYear() int {
+	var year, _ = v.asTime().ISOWeek()
+	return year
+}
+
+func (v moment_) GetWeekday() uint {
+	// ISO 8601 weekdays run from Monday (1) to Sunday (7).
+	var weekday = (int(v.asTime().Weekday())+6)%7 + 1
+	return uint(weekday)
+}
+
+func (v moment_) GetDayOfYear() uint {
+	return uint(v.asTime().YearDay())
 }
 
 // Attribute Methods
@@ -111,10 +394,6 @@ func (v moment_) AsSource() string {
 	var year = v.GetYears()
 	var month = v.GetMonths()
 	var day = v.GetDays()
-	var hour = v.GetHours()
-	var minute = v.GetMinutes()
-	var second = v.GetSeconds()
-	var millisecond = v.GetMilliseconds()
 	builder.WriteString("<")
 	if v.IsNegative() {
 		builder.WriteString("-")
@@ -124,88 +403,82 @@ func (v moment_) AsSource() string {
 	builder.WriteString(momentClass().formatOrdinal(month, 2))
 	builder.WriteString("-")
 	builder.WriteString(momentClass().formatOrdinal(day, 2))
-	if hour > 0 || minute > 0 || second > 0 || millisecond > 0 {
-		builder.WriteString("T")
-		builder.WriteString(momentClass().formatOrdinal(hour, 2))
-		if minute > 0 || second > 0 || millisecond > 0 {
-			builder.WriteString(":")
-			builder.WriteString(momentClass().formatOrdinal(minute, 2))
-			if second > 0 || millisecond > 0 {
-				builder.WriteString(":")
-				builder.WriteString(momentClass().formatOrdinal(second, 2))
-				if millisecond > 0 {
-					builder.WriteString(".")
-					builder.WriteString(momentClass().formatOrdinal(millisecond, 3))
-				}
-			}
-		}
-	}
+	builder.WriteString(v.formatTime())
 	builder.WriteString(">")
 	return builder.String()
 }
 
 func (v moment_) AsInteger() int {
-	return int(v)
+	return v.milliseconds_
 }
 
 func (v moment_) IsDefined() bool {
-	return v > mat.MinInt64 && v < mat.MaxInt64
+	return v.milliseconds_ > mat.MinInt64 && v.milliseconds_ < mat.MaxInt64
 }
 
 func (v moment_) IsMinimum() bool {
-	return v == mat.MinInt64
+	return v.milliseconds_ == mat.MinInt64
 }
 
 func (v moment_) IsZero() bool {
-	return v == 0
+	return v.milliseconds_ == 0 && v.nanoseconds_ == 0
 }
 
 func (v moment_) IsMaximum() bool {
-	return v == mat.MaxInt64
+	return v.milliseconds_ == mat.MaxInt64
 }
 
 // Polarized Methods
 
 func (v moment_) IsNegative() bool {
-	return v < -62167219200000
+	return v.milliseconds_ < -62167219200000
 }
 
 // Temporal Methods
 
 func (v moment_) AsMilliseconds() float64 {
-	return float64(v)
+	var nanoseconds = float64(durationClass().nanosecondsPerMillisecond_)
+	return float64(v.milliseconds_) + float64(v.nanoseconds_)/nanoseconds
 }
 
 func (v moment_) AsSeconds() float64 {
-	return float64(v) / float64(durationClass().millisecondsPerSecond_)
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerSecond_)
 }
 
 func (v moment_) AsMinutes() float64 {
-	return float64(v) / float64(durationClass().millisecondsPerMinute_)
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerMinute_)
 }
 
 func (v moment_) AsHours() float64 {
-	return float64(v) / float64(durationClass().millisecondsPerHour_)
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerHour_)
 }
 
 func (v moment_) AsDays() float64 {
-	return float64(v) / float64(durationClass().millisecondsPerDay_)
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerDay_)
 }
 
 func (v moment_) AsWeeks() float64 {
-	return float64(v) / float64(durationClass().millisecondsPerWeek_)
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerWeek_)
 }
 
 func (v moment_) AsMonths() float64 {
-	return float64(v) / float64(durationClass().millisecondsPerMonth_)
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerMonth_)
 }
 
 func (v moment_) AsYears() float64 {
-	return float64(v) / float64(durationClass().millisecondsPerYear_)
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerYear_)
 }
 
 // Factored Methods
 
+func (v moment_) GetNanoseconds() uint {
+	return uint(v.nanoseconds_ % 1000)
+}
+
+func (v moment_) GetMicroseconds() uint {
+	return uint(v.nanoseconds_ / 1000)
+}
+
 func (v moment_) GetMilliseconds() uint {
 	var time = v.asTime()
 	var milliseconds = time.Nanosecond() / 1e6
@@ -257,12 +530,224 @@ func (v moment_) GetYears() uint {
 	return uint(years)
 }
 
+// Ordered[MomentLike] Methods
+
+func (v moment_) IsBefore(
+	value MomentLike,
+) bool {
+	return momentClass().Compare(v, value) < 0
+}
+
 // PROTECTED INTERFACE
 
+func (v Period) String() string {
+	var source string
+	switch v {
+	case DayPeriod:
+		source = "DayPeriod"
+	case WeekPeriod:
+		source = "WeekPeriod"
+	case MonthPeriod:
+		source = "MonthPeriod"
+	case QuarterPeriod:
+		source = "QuarterPeriod"
+	case YearPeriod:
+		source = "YearPeriod"
+	}
+	return source
+}
+
 func (v moment_) String() string {
 	return v.AsSource()
 }
 
+func (v moment_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *moment_) UnmarshalText(
+	text []byte,
+) error {
+	var moment, err = momentClass().ParseMoment(string(text))
+	if err != nil {
+		return err
+	}
+	*v = moment.(moment_)
+	return nil
+}
+
+func (v moment_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *moment_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v moment_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	if v.nanoseconds_ == 0 {
+		return jsn.Marshal(v.AsIntrinsic())
+	}
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *moment_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	if !jsonClass().isString(bytes) {
+		var milliseconds int
+		var err = jsn.Unmarshal(bytes, &milliseconds)
+		if err != nil {
+			return err
+		}
+		*v = moment_{milliseconds_: milliseconds}
+		return nil
+	}
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v moment_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = formatClass().Header(MomentType)
+	bytes = bin.AppendVarint(bytes, int64(v.milliseconds_))
+	if v.nanoseconds_ != 0 {
+		bytes = bin.AppendVarint(bytes, int64(v.nanoseconds_))
+	}
+	return
+}
+
+func (v *moment_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = formatClass().Payload(MomentType, bytes)
+	if err != nil {
+		return err
+	}
+	var milliseconds, size = bin.Varint(payload)
+	if size <= 0 {
+		return fmt.Errorf("The binary payload is not a moment: %x", payload)
+	}
+	var nanoseconds int64
+	if size < len(payload) {
+		// The moment has sub-millisecond precision.
+		var rest int
+		nanoseconds, rest = bin.Varint(payload[size:])
+		if rest <= 0 || size+rest != len(payload) || nanoseconds <= 0 ||
+			nanoseconds >= int64(durationClass().nanosecondsPerMillisecond_) {
+			return fmt.Errorf("The binary payload is not a moment: %x", payload)
+		}
+	}
+	*v = moment_{int(milliseconds), int(nanoseconds)}
+	return nil
+}
+
+func (v moment_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	var milliseconds = v.milliseconds_
+	if v.nanoseconds_ != 0 {
+		// A floating point number of seconds would lose the precision.
+		return cborClass().Encode(
+			momentClass().preciseTag_,
+			[]any{milliseconds, v.nanoseconds_},
+		)
+	}
+	if milliseconds%1000 == 0 {
+		return cborClass().Encode(momentClass().cborTag_, milliseconds/1000)
+	}
+	return cborClass().Encode(
+		momentClass().cborTag_,
+		float64(milliseconds)/1000.0,
+	)
+}
+
+func (v *moment_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = cborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag == momentClass().preciseTag_ {
+		var items, _ = value.([]any)
+		if len(items) != 2 {
+			return fmt.Errorf("The CBOR data item is not a moment: %x", bytes)
+		}
+		var milliseconds, failure = cborClass().integerFromValue(items[0])
+		var nanoseconds, problem = cborClass().integerFromValue(items[1])
+		if failure != nil || problem != nil || nanoseconds <= 0 ||
+			nanoseconds >= int(durationClass().nanosecondsPerMillisecond_) {
+			return fmt.Errorf("The CBOR data item is not a moment: %x", bytes)
+		}
+		*v = moment_{milliseconds, nanoseconds}
+		return nil
+	}
+	if tag != momentClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not a moment: %x", bytes)
+	}
+	switch seconds := value.(type) {
+	case uint64:
+		*v = moment_{milliseconds_: int(seconds * 1000)}
+	case int64:
+		*v = moment_{milliseconds_: int(seconds * 1000)}
+	case float64:
+		*v = moment_{milliseconds_: int(mat.Round(seconds * 1000.0))}
+	default:
+		return fmt.Errorf("The CBOR data item is not a moment: %x", bytes)
+	}
+	return nil
+}
+
+func (v moment_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.asTime()
+	return
+}
+
+func (v *moment_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case tim.Time:
+		*v = momentClass().momentFromTime(actual)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a moment: %v",
+			source,
+		)
+	}
+}
+
 // Private Methods
 
 func (c *momentClass_) formatOrdinal(ordinal uint, digits int) string {
@@ -312,7 +797,14 @@ var hackedIsoFormats_ = [...]string{
 //	https://en.wikipedia.org/wiki/Holocene_calendar#Conversion
 //
 // we must resort to some hacking with this private function...
-func (c *momentClass_) momentFromMatches(matches []string) int {
+func (c *momentClass_) timeFromMatches(matches []string) (
+	time tim.Time,
+	ok bool,
+) {
+	if len(matches[6]) > 0 || len(matches[8]) > 0 {
+		return c.timeFromWeekOrOrdinal(matches)
+	}
+
 	// First, we replace the year with year zero.
 	var sign = matches[2]
 	var yearString = matches[3]
@@ -329,22 +821,201 @@ func (c *momentClass_) momentFromMatches(matches []string) int {
 				// We change the positive date to a negative one.
 				year = -year
 			}
-			date = date.AddDate(int(year), 0, 0)
+			time = date.AddDate(int(year), 0, 0)
+			ok = true
+			return
+		}
+	}
 
-			// And return the correct date as milliseconds.
-			var milliseconds = int(date.UnixMilli())
-			return milliseconds
+	// The regular expressions cannot rule out every illegal date (e.g. the
+	// 30th of February) so the moment was matched but is not a real date.
+	return
+}
+
+// This private method returns the matcher for the form of date (calendar, week
+// or ordinal) that the specified source string is using.  Otherwise a malformed
+// calendar date like "<2024-13-01>" would be reported against the ordinal form
+// since "<2024-13" is also the start of an ordinal date.
+func (c *momentClass_) formOf(
+	source string,
+) *reg.Regexp {
+	var matches = c.formMatcher_.FindStringSubmatch(source)
+	switch {
+	case len(matches) > 0 && len(matches[1]) > 0:
+		return c.weekMatcher_
+	case len(matches) > 0 && len(matches[2]) > 0:
+		return c.ordinalMatcher_
+	default:
+		return c.calendarMatcher_
+	}
+}
+
+// This private method returns the time for the ISO 8601 week date (e.g.
+// "2024-W05-3") or ordinal date (e.g. "2024-123") in the specified matches,
+// neither of which the Go time.Parse() function supports.  Since the dates are
+// computed directly there is no need for the year zero hack used above.
+func (c *momentClass_) timeFromWeekOrOrdinal(matches []string) (
+	time tim.Time,
+	ok bool,
+) {
+	var year, _ = stc.Atoi(matches[3])
+	if matches[2] == "-" {
+		year = -year
+	}
+	var hour, _ = stc.Atoi("0" + matches[9])
+	var minute, _ = stc.Atoi("0" + matches[10])
+	var second, _ = stc.Atoi("0" + matches[11])
+	if second > 59 {
+		// The Go time package does not support leap seconds.
+		return
+	}
+	var nanosecond int
+	if len(matches[12]) > 0 {
+		var digits = (matches[12][1:] + "00000000")[:9]
+		nanosecond, _ = stc.Atoi(digits)
+	}
+
+	if len(matches[8]) > 0 {
+		// An ordinal date must not spill over into the following year.
+		var day, _ = stc.Atoi(matches[8])
+		time = tim.Date(year, 1, day, hour, minute, second, nanosecond, tim.UTC)
+		ok = time.Year() == year
+		return
+	}
+
+	// The first week of an ISO week-year is the one containing January 4th.
+	var week, _ = stc.Atoi(matches[6])
+	var weekday, _ = stc.Atoi(matches[7])
+	var january = tim.Date(year, 1, 4, hour, minute, second, nanosecond, tim.UTC)
+	var monday = january.AddDate(0, 0, -(int(january.Weekday())+6)%7)
+	time = monday.AddDate(0, 0, 7*(week-1)+weekday-1)
+	var weekYear, weekNumber = time.ISOWeek()
+	ok = weekYear == year && weekNumber == week
+	return
+}
+
+// This private method returns the location for the specified time zone, which
+// may be "Z", a UTC offset (e.g. "+05:30") or an IANA zone name (e.g.
+// "Europe/Paris").  The IANA zone names are resolved using the time zone
+// database that is embedded in this package.
+func (c *momentClass_) locationFromZone(
+	zone string,
+) (
+	location *tim.Location,
+	err error,
+) {
+	switch {
+	case zone == "Z":
+		location = tim.UTC
+	case len(zone) > 0 && (zone[0] == '+' || zone[0] == '-'):
+		var matches = c.offsetMatcher_.FindStringSubmatch(zone)
+		if uti.IsUndefined(matches) {
+			err = fmt.Errorf("An invalid UTC offset was specified: %v", zone)
+			return
+		}
+		var hours, _ = stc.Atoi(matches[2])
+		var minutes, _ = stc.Atoi("0" + matches[3])
+		var seconds = hours*3600 + minutes*60
+		if matches[1] == "-" {
+			seconds = -seconds
 		}
+		location = tim.FixedZone(zone, seconds)
+	case zone == "Local":
+		// The local zone of the host would make the moment non-portable.
+		err = fmt.Errorf("The local time zone is not supported.")
+	default:
+		location, err = tim.LoadLocation(zone)
 	}
+	return
+}
+
+// This private method converts the specified UTC time, which represents a wall
+// clock time in the specified location, into the actual time in that location.
+func (c *momentClass_) timeInLocation(
+	wall tim.Time,
+	location *tim.Location,
+) tim.Time {
+	return tim.Date(
+		wall.Year(),
+		wall.Month(),
+		wall.Day(),
+		wall.Hour(),
+		wall.Minute(),
+		wall.Second(),
+		wall.Nanosecond(),
+		location,
+	)
+}
+
+// This private method converts the specified time into a moment, retaining any
+// sub-millisecond precision.
+func (c *momentClass_) momentFromTime(
+	time tim.Time,
+) moment_ {
+	var milliseconds = int(time.UnixMilli())
+	var nanoseconds = time.Nanosecond() % int(durationClass().nanosecondsPerMillisecond_)
+	return moment_{milliseconds, nanoseconds}
+}
+
+// This private method returns the concrete moment for the specified moment,
+// retaining any sub-millisecond precision.
+func (c *momentClass_) momentFrom(
+	moment MomentLike,
+) moment_ {
+	var nanoseconds = moment.GetMicroseconds()*1000 + moment.GetNanoseconds()
+	return moment_{moment.AsIntrinsic(), int(nanoseconds)}
+}
 
-	// This panic will only happen if the regular expressions are out of sync
-	// with the ISO 8601 standard formats. The moment has already been matched
-	// succussfully.
-	panic(fmt.Sprintf("The moment does not match a known format: %v", matches[0]))
+// This private method returns the moment with the specified number of
+// milliseconds and nanoseconds, carrying any nanoseconds outside of the range
+// [0..999999] into the milliseconds.
+func (c *momentClass_) normalized(
+	milliseconds int,
+	nanoseconds int,
+) moment_ {
+	var perMillisecond = int(durationClass().nanosecondsPerMillisecond_)
+	milliseconds += nanoseconds / perMillisecond
+	nanoseconds %= perMillisecond
+	if nanoseconds < 0 {
+		milliseconds--
+		nanoseconds += perMillisecond
+	}
+	return moment_{milliseconds, nanoseconds}
 }
 
 func (v moment_) asTime() tim.Time {
-	return tim.UnixMilli(int64(v)).UTC()
+	var time = tim.UnixMilli(int64(v.milliseconds_))
+	return time.Add(tim.Duration(v.nanoseconds_)).UTC()
+}
+
+// This private method returns the time of day portion of the source for this
+// moment (e.g. "T09:30"), omitting any trailing fields that are zero.
+func (v moment_) formatTime() string {
+	var builder sts.Builder
+	var hour = v.GetHours()
+	var minute = v.GetMinutes()
+	var second = v.GetSeconds()
+	var millisecond = v.GetMilliseconds()
+	var nanosecond = uint(v.nanoseconds_)
+	if hour > 0 || minute > 0 || second > 0 || millisecond > 0 || nanosecond > 0 {
+		builder.WriteString("T")
+		builder.WriteString(momentClass().formatOrdinal(hour, 2))
+		if minute > 0 || second > 0 || millisecond > 0 || nanosecond > 0 {
+			builder.WriteString(":")
+			builder.WriteString(momentClass().formatOrdinal(minute, 2))
+			if second > 0 || millisecond > 0 || nanosecond > 0 {
+				builder.WriteString(":")
+				builder.WriteString(momentClass().formatOrdinal(second, 2))
+				if millisecond > 0 || nanosecond > 0 {
+					builder.WriteString(".")
+					builder.WriteString(
+						durationClass().formatFraction(millisecond, nanosecond),
+					)
+				}
+			}
+		}
+	}
+	return builder.String()
 }
 
 // NOTE:
@@ -355,24 +1026,105 @@ func (v moment_) asTime() tim.Time {
 // each name to lessen the chance of a name collision with other private Go
 // class constants in this package.
 const (
-	day_    = "0[1-9]|[1-2][0-9]|3[0-1]"
-	hour_   = "[0-1][0-9]|2[0-3]"
-	minute_ = "[0-5][0-9]"
-	month_  = "0[1-9]|1[0-2]"
-	second_ = "[0-5][0-9]|6[0-1]"
-	year_   = "0|" + ordinal_
+	day_       = "0[1-9]|[1-2][0-9]|3[0-1]"
+	dayOfWeek_ = "[1-7]"
+	dayOfYear_ = "00[1-9]|0[1-9][0-9]|[1-2][0-9][0-9]|3[0-5][0-9]|36[0-6]"
+	hour_      = "[0-1][0-9]|2[0-3]"
+	minute_    = "[0-5][0-9]"
+	month_     = "0[1-9]|1[0-2]"
+	second_    = "[0-5][0-9]|6[0-1]"
+	subsecond_ = "\\.[0-9]{1,9}"
+	week_      = "0[1-9]|[1-4][0-9]|5[0-3]"
+	year_      = "0|" + ordinal_
+	zone_      = "Z|(?:" + sign_ + ")(?:" + hour_ + ")(?::(?:" + minute_ +
+		"))?|\\[[A-Za-z][A-Za-z0-9_+-]*(?:/[A-Za-z0-9_+-]+)*\\]"
+
+	// The three forms of date share the capture groups of the moment matcher.
+	// A form that is replaced by its "no" counterpart never matches, so that a
+	// parse error can be reported for the form that the source string is using.
+	calendarDate_ = "(" + month_ + ")-(" + day_ + ")"
+	dateEnd_      = ")(?:T(" + hour_ + ")(?::(" + minute_ + ")(?::(" +
+		second_ + ")(" + subsecond_ + ")?)?)?)?)(" + zone_ + ")?>"
+	dateStart_      = "^<((" + sign_ + ")?(" + year_ + ")-(?:"
+	never_          = "[^\\x00-\\x{10FFFF}]"
+	noCalendarDate_ = "(" + never_ + ")-(" + never_ + ")"
+	noOrdinalDate_  = "(" + never_ + ")"
+	noWeekDate_     = "W(" + never_ + ")-(" + never_ + ")"
+	ordinalDate_    = "(" + dayOfYear_ + ")"
+	weekDate_       = "W(" + week_ + ")-(" + dayOfWeek_ + ")"
 )
 
 // Instance Structure
 
-type moment_ int
+type moment_ struct {
+	milliseconds_ int // Since the epoch.
+	nanoseconds_  int // Within the millisecond [0..999999].
+}
+
+// This private type renders the factors of a moment in a specific time zone.
+type factors_ struct {
+	time_ tim.Time
+}
+
+func (v factors_) GetNanoseconds() uint {
+	return uint(v.time_.Nanosecond() % 1000)
+}
+
+func (v factors_) GetMicroseconds() uint {
+	return uint(v.time_.Nanosecond() / 1000 % 1000)
+}
+
+func (v factors_) GetMilliseconds() uint {
+	return uint(v.time_.Nanosecond() / 1e6)
+}
+
+func (v factors_) GetSeconds() uint {
+	return uint(v.time_.Second())
+}
+
+func (v factors_) GetMinutes() uint {
+	return uint(v.time_.Minute())
+}
+
+func (v factors_) GetHours() uint {
+	return uint(v.time_.Hour())
+}
+
+func (v factors_) GetDays() uint {
+	return uint(v.time_.Day())
+}
+
+func (v factors_) GetWeeks() uint {
+	var _, weeks = v.time_.ISOWeek()
+	return uint(weeks)
+}
+
+func (v factors_) GetMonths() uint {
+	return uint(v.time_.Month())
+}
+
+func (v factors_) GetYears() uint {
+	var years = v.time_.Year()
+	if years < 0 {
+		years = -years
+	}
+	return uint(years)
+}
 
 // Class Structure
 
 type momentClass_ struct {
 	// Declare the class constants.
-	matcher_ *reg.Regexp
-	epoch_   MomentLike
+	matcher_         *reg.Regexp
+	formMatcher_     *reg.Regexp
+	calendarMatcher_ *reg.Regexp
+	weekMatcher_     *reg.Regexp
+	ordinalMatcher_  *reg.Regexp
+	offsetMatcher_   *reg.Regexp
+	productions_     map[int]string
+	epoch_           MomentLike
+	cborTag_         uint64
+	preciseTag_      uint64
 }
 
 // Class Reference
@@ -384,9 +1136,42 @@ func momentClass() *momentClass_ {
 var momentClassReference_ = &momentClass_{
 	// Initialize the class constants.
 	matcher_: reg.MustCompile(
-		"^<((" + sign_ + ")?(" + year_ + ")-(" + month_ + ")-(" + day_ +
-			")(?:T(" + hour_ + ")(?::(" + minute_ + ")(?::(" + second_ +
-			")(" + fraction_ + ")?)?)?)?)>",
+		dateStart_ + calendarDate_ + "|" + weekDate_ + "|" + ordinalDate_ +
+			dateEnd_,
+	),
+	formMatcher_: reg.MustCompile(
+		"^<(?:" + sign_ + ")?[0-9]*-(?:(W)|([0-9]{3})(?:[^0-9-]|$))",
+	),
+	calendarMatcher_: reg.MustCompile(
+		dateStart_ + calendarDate_ + "|" + noWeekDate_ + "|" + noOrdinalDate_ +
+			dateEnd_,
+	),
+	weekMatcher_: reg.MustCompile(
+		dateStart_ + noCalendarDate_ + "|" + weekDate_ + "|" + noOrdinalDate_ +
+			dateEnd_,
+	),
+	ordinalMatcher_: reg.MustCompile(
+		dateStart_ + noCalendarDate_ + "|" + noWeekDate_ + "|" + ordinalDate_ +
+			dateEnd_,
+	),
+	offsetMatcher_: reg.MustCompile(
+		"^(" + sign_ + ")(" + hour_ + ")(?::(" + minute_ + "))?$",
 	),
-	epoch_: moment_(0),
+	productions_: map[int]string{
+		2:  "sign",
+		3:  "year",
+		4:  "month 01-12",
+		5:  "day 01-31",
+		6:  "week 01-53",
+		7:  "weekday 1-7",
+		8:  "day of year 001-366",
+		9:  "hour 00-23",
+		10: "minute 00-59",
+		11: "second 00-61",
+		12: "fraction of a second",
+		13: "time zone",
+	},
+	epoch_:      moment_{},
+	cborTag_:    1,
+	preciseTag_: 0x63646705,
 }
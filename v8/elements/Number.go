@@ -13,12 +13,19 @@
 package elements
 
 import (
+	drv "database/sql/driver"
+	jsn "encoding/json"
 	fmt "fmt"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	mat "math"
+	big "math/big"
 	cmp "math/cmplx"
 	reg "regexp"
+	sli "slices"
 	stc "strconv"
+	sts "strings"
+	utf "unicode/utf8"
 )
 
 // CLASS INTERFACE
@@ -99,7 +106,7 @@ func (c *numberClass_) NumberFromPolar(
 	angle float64,
 ) NumberLike {
 	// Go complex types only use rectangular form so convert to rectangular.
-	var complex_ = cmp.Rect(magnitude, angle)
+	var complex_ = c.rectangularFromPolar(magnitude, angle)
 	return c.normalize(complex_)
 }
 
@@ -125,19 +132,49 @@ func (c *numberClass_) NumberFromFloat(
 	return c.normalize(complex_)
 }
 
+func (c *numberClass_) NumberFromBigFloat(
+	float *big.Float,
+) NumberLike {
+	var real_, _ = float.Float64() // Rounded to the nearest float64.
+	return c.NumberFromFloat(real_)
+}
+
+func (c *numberClass_) NumberFromBigRat(
+	rational *big.Rat,
+) NumberLike {
+	var real_, _ = rational.Float64() // Rounded to the nearest float64.
+	return c.NumberFromFloat(real_)
+}
+
 func (c *numberClass_) NumberFromSource(
 	source string,
 ) NumberLike {
-	var matches = c.matcher_.FindStringSubmatch(source)
+	var number, err = c.ParseNumber(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return number
+}
+
+func (c *numberClass_) ParseNumber(
+	source string,
+) (
+	number NumberLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
 	if uti.IsUndefined(matches) {
-		var message = fmt.Sprintf(
-			"An illegal string was passed to the number constructor method: %s",
+		err = parseErrorClass().ParseErrorFromMatcher(
+			"number",
+			c.matcher_,
+			c.productions_,
 			source,
 		)
-		panic(message)
+		return
 	}
 	var complex_ = c.complexFromMatches(matches)
-	return c.normalize(complex_)
+	number = c.normalize(complex_)
+	return
 }
 
 // Constant Methods
@@ -188,6 +225,69 @@ func (c *numberClass_) Infinity() NumberLike {
 
 // Function Methods
 
+// NOTE:
+// This function returns a new number class that uses the specified precision
+// policy.  The significant digits are only used by the significant precision
+// and must be from 1 to 17.  The shared number class always uses the locked
+// precision, so a policy never changes the numbers of any other caller.
+func (c *numberClass_) WithPrecision(
+	precision Precision,
+	digits uint,
+) (
+	class NumberClassLike,
+	err error,
+) {
+	var configured = *c
+	switch precision {
+	case LockedPrecision, ExactPrecision:
+		// The default significant digits are used for the source form.
+	case SignificantPrecision:
+		if digits < 1 || digits > 17 {
+			err = fmt.Errorf(
+				"The number of significant digits must be from 1 to 17: %v",
+				digits,
+			)
+			return
+		}
+		configured.digits_ = digits
+	default:
+		err = fmt.Errorf(
+			"An invalid number precision was specified: %v",
+			precision,
+		)
+		return
+	}
+	configured.precision_ = precision
+	class = &configured
+	return
+}
+
+func (c *numberClass_) GetPrecision() Precision {
+	return c.precision_
+}
+
+func (c *numberClass_) GetSignificantDigits() uint {
+	return c.digits_
+}
+
+func (c *numberClass_) FormatRectangular(
+	number NumberLike,
+) string {
+	return number_(number.AsIntrinsic()).rectangularSource(c.sourceFromFloat)
+}
+
+func (c *numberClass_) FormatPolar(
+	number NumberLike,
+) string {
+	return c.polarSource(number_(number.AsIntrinsic()))
+}
+
+func (c *numberClass_) FormatEngineering(
+	number NumberLike,
+) string {
+	return number_(number.AsIntrinsic()).rectangularSource(c.engineeringFromFloat)
+}
+
 func (c *numberClass_) Inverse(
 	number NumberLike,
 ) NumberLike {
@@ -365,6 +465,287 @@ func (c *numberClass_) Logarithm(
 	return c.normalize(logB)
 }
 
+func (c *numberClass_) Compare(
+	first NumberLike,
+	second NumberLike,
+) int {
+	var firstKey = c.orderingKey(first)
+	var secondKey = c.orderingKey(second)
+	for index := range firstKey {
+		switch {
+		case firstKey[index] < secondKey[index]:
+			return -1
+		case firstKey[index] > secondKey[index]:
+			return 1
+		}
+	}
+	return 0
+}
+
+func (c *numberClass_) ApproximatelyEqual(
+	first NumberLike,
+	second NumberLike,
+	tolerance float64,
+) bool {
+	switch {
+	case !first.IsDefined() || !second.IsDefined():
+		// An undefined number is not even equal to itself.
+		return false
+	case !first.HasMagnitude() && !first.IsZero(),
+		!second.HasMagnitude() && !second.IsZero():
+		// An infinity is only approximately equal to the same infinity.
+		return first.AsIntrinsic() == second.AsIntrinsic()
+	default:
+		var distance = cmp.Abs(first.AsIntrinsic() - second.AsIntrinsic())
+		return distance <= tolerance
+	}
+}
+
+func (c *numberClass_) Absolute(
+	number NumberLike,
+) NumberLike {
+	var result_ NumberLike
+	switch {
+	case !number.IsDefined():
+		result_ = c.undefined_
+	case !number.HasMagnitude() && !number.IsZero():
+		// The magnitude of any infinity is positive infinity.
+		result_ = c.maximum_
+	default:
+		result_ = c.normalize(complex(cmp.Abs(number.AsIntrinsic()), 0))
+	}
+	return result_
+}
+
+func (c *numberClass_) SquareRoot(
+	number NumberLike,
+) NumberLike {
+	return c.normalize(cmp.Sqrt(number.AsIntrinsic()))
+}
+
+func (c *numberClass_) NthRoots(
+	number NumberLike,
+	degree uint,
+) []NumberLike {
+	if degree == 0 {
+		panic("The degree of a root must be greater than zero.")
+	}
+	var roots = make([]NumberLike, degree)
+	switch {
+	case !number.IsDefined():
+		for index := range roots {
+			roots[index] = c.undefined_
+		}
+	case number.IsZero():
+		for index := range roots {
+			roots[index] = c.zero_
+		}
+	case !number.HasMagnitude():
+		for index := range roots {
+			roots[index] = c.infinity_
+		}
+	default:
+		// The n roots are evenly spaced around a circle starting with the
+		// principal root.
+		var n = float64(degree)
+		var magnitude = mat.Pow(number.GetMagnitude(), 1.0/n)
+		var angle = number.GetAngle() / n
+		for index := range roots {
+			var phase = angle + 2.0*mat.Pi*float64(index)/n
+			roots[index] = c.normalize(c.rectangularFromPolar(magnitude, phase))
+		}
+	}
+	return roots
+}
+
+func (c *numberClass_) Exponential(
+	number NumberLike,
+) NumberLike {
+	var result_ NumberLike
+	switch {
+	case !number.IsDefined():
+		result_ = c.undefined_
+	case number.IsMinimum():
+		// The exponential of negative infinity is zero.
+		result_ = c.zero_
+	case number.IsMaximum():
+		// The exponential of positive infinity is positive infinity.
+		result_ = c.maximum_
+	case number.IsInfinite():
+		// The exponential of a complex infinity has no limit.
+		result_ = c.undefined_
+	default:
+		// e^(x+iy) => e^x(cos(y) + i sin(y))
+		var complex_ = number.AsIntrinsic()
+		var magnitude = mat.Exp(real(complex_))
+		result_ = c.normalize(c.rectangularFromPolar(magnitude, imag(complex_)))
+	}
+	return result_
+}
+
+func (c *numberClass_) Gamma(
+	number NumberLike,
+) NumberLike {
+	var result_ NumberLike
+	switch {
+	case !number.IsDefined():
+		result_ = c.undefined_
+	case number.IsMaximum():
+		// The gamma function grows without bound along the positive reals.
+		result_ = c.maximum_
+	case !number.HasMagnitude() && !number.IsZero():
+		// The gamma function has no limit at any other infinity.
+		result_ = c.undefined_
+	default:
+		var complex_ = number.AsIntrinsic()
+		var real_ = real(complex_)
+		switch {
+		case imag(complex_) != 0:
+			result_ = c.normalize(c.complexGamma(complex_))
+		case real_ <= 0 && real_ == mat.Trunc(real_):
+			// The gamma function has a pole at zero and each negative integer.
+			result_ = c.infinity_
+		default:
+			result_ = c.normalize(complex(mat.Gamma(real_), 0))
+		}
+	}
+	return result_
+}
+
+func (c *numberClass_) Sine(
+	number NumberLike,
+) NumberLike {
+	var result_ NumberLike
+	switch {
+	case !number.IsDefined() || !number.HasMagnitude() && !number.IsZero():
+		// The trigonometric functions have no limit at infinity.
+		result_ = c.undefined_
+	default:
+		// sin(x+iy) => sin(x)cosh(y) + i cos(x)sinh(y)
+		var complex_ = number.AsIntrinsic()
+		var sine, cosine = c.sineAndCosine(real(complex_))
+		var y = imag(complex_)
+		result_ = c.normalize(complex(
+			c.scaled(sine, mat.Cosh(y)),
+			c.scaled(cosine, mat.Sinh(y)),
+		))
+	}
+	return result_
+}
+
+func (c *numberClass_) ArcSine(
+	number NumberLike,
+) NumberLike {
+	return c.normalize(cmp.Asin(number.AsIntrinsic()))
+}
+
+func (c *numberClass_) Cosine(
+	number NumberLike,
+) NumberLike {
+	var result_ NumberLike
+	switch {
+	case !number.IsDefined() || !number.HasMagnitude() && !number.IsZero():
+		// The trigonometric functions have no limit at infinity.
+		result_ = c.undefined_
+	default:
+		// cos(x+iy) => cos(x)cosh(y) - i sin(x)sinh(y)
+		var complex_ = number.AsIntrinsic()
+		var sine, cosine = c.sineAndCosine(real(complex_))
+		var y = imag(complex_)
+		result_ = c.normalize(complex(
+			c.scaled(cosine, mat.Cosh(y)),
+			-c.scaled(sine, mat.Sinh(y)),
+		))
+	}
+	return result_
+}
+
+func (c *numberClass_) ArcCosine(
+	number NumberLike,
+) NumberLike {
+	return c.normalize(cmp.Acos(number.AsIntrinsic()))
+}
+
+func (c *numberClass_) Tangent(
+	number NumberLike,
+) NumberLike {
+	// tan(z) => sin(z) / cos(z)
+	return c.Quotient(c.Sine(number), c.Cosine(number))
+}
+
+func (c *numberClass_) ArcTangent(
+	number NumberLike,
+) NumberLike {
+	return c.normalize(cmp.Atan(number.AsIntrinsic()))
+}
+
+func (c *numberClass_) HyperbolicSine(
+	number NumberLike,
+) NumberLike {
+	var result_ NumberLike
+	switch {
+	case !number.IsDefined() || !number.HasMagnitude() && !number.IsZero():
+		// The hyperbolic functions have no limit at infinity.
+		result_ = c.undefined_
+	default:
+		// sinh(x+iy) => sinh(x)cos(y) + i cosh(x)sin(y)
+		var complex_ = number.AsIntrinsic()
+		var x = real(complex_)
+		var sine, cosine = c.sineAndCosine(imag(complex_))
+		result_ = c.normalize(complex(
+			c.scaled(mat.Sinh(x), cosine),
+			c.scaled(mat.Cosh(x), sine),
+		))
+	}
+	return result_
+}
+
+func (c *numberClass_) ArcHyperbolicSine(
+	number NumberLike,
+) NumberLike {
+	return c.normalize(cmp.Asinh(number.AsIntrinsic()))
+}
+
+func (c *numberClass_) HyperbolicCosine(
+	number NumberLike,
+) NumberLike {
+	var result_ NumberLike
+	switch {
+	case !number.IsDefined() || !number.HasMagnitude() && !number.IsZero():
+		// The hyperbolic functions have no limit at infinity.
+		result_ = c.undefined_
+	default:
+		// cosh(x+iy) => cosh(x)cos(y) + i sinh(x)sin(y)
+		var complex_ = number.AsIntrinsic()
+		var x = real(complex_)
+		var sine, cosine = c.sineAndCosine(imag(complex_))
+		result_ = c.normalize(complex(
+			c.scaled(mat.Cosh(x), cosine),
+			c.scaled(mat.Sinh(x), sine),
+		))
+	}
+	return result_
+}
+
+func (c *numberClass_) ArcHyperbolicCosine(
+	number NumberLike,
+) NumberLike {
+	return c.normalize(cmp.Acosh(number.AsIntrinsic()))
+}
+
+func (c *numberClass_) HyperbolicTangent(
+	number NumberLike,
+) NumberLike {
+	// tanh(z) => sinh(z) / cosh(z)
+	return c.Quotient(c.HyperbolicSine(number), c.HyperbolicCosine(number))
+}
+
+func (c *numberClass_) ArcHyperbolicTangent(
+	number NumberLike,
+) NumberLike {
+	return c.normalize(cmp.Atanh(number.AsIntrinsic()))
+}
+
 // INSTANCE INTERFACE
 
 // Principal Methods
@@ -378,59 +759,50 @@ func (v number_) AsIntrinsic() complex128 {
 }
 
 func (v number_) AsRectangular() string {
-	var source string
-	switch {
-	case v.IsZero():
-		source = "0"
-	case v.IsMinimum():
-		source = "-∞"
-	case v.IsMaximum():
-		source = "+∞"
-	case v.IsInfinite():
-		source = "∞"
-	case !v.IsDefined():
-		source = "undefined"
-	default:
-		var realPart = v.GetReal()
-		var imagPart = v.GetImaginary()
+	return numberClass().FormatRectangular(v)
+}
+
+func (v number_) AsBase(
+	base uint,
+) string {
+	if base == 10 {
+		return v.AsRectangular()
+	}
+	var class = numberClass()
+	var prefix, ok = class.radixPrefixes_[base]
+	if !ok {
+		var message = fmt.Sprintf(
+			"A number can only be formatted in base 2, 8, 10 or 16: %v",
+			base,
+		)
+		panic(message)
+	}
+	return v.rectangularSource(func(float float64) string {
+		var sign string
+		if float < 0 {
+			sign = "-"
+		}
+		var magnitude = mat.Abs(float)
 		switch {
-		case imagPart == 0:
-			source = numberClass().sourceFromFloat(realPart)
-		case realPart == 0:
-			source = numberClass().sourceFromFloat(imagPart) + "i"
+		case magnitude == mat.Trunc(magnitude) && magnitude < 0x1p64:
+			var digits = stc.FormatUint(uint64(magnitude), int(base))
+			return sign + prefix + sts.ToUpper(digits)
+		case base == 16:
+			// Only a hexadecimal floating point form is exact for fractions.
+			var digits = stc.FormatFloat(magnitude, 'X', -1, 64)[2:]
+			return sign + prefix + sts.Replace(digits, "P", "p", 1)
 		default:
-			source = numberClass().sourceFromFloat(realPart)
-			if imagPart > 0 {
-				source += "+"
-			}
-			source += numberClass().sourceFromFloat(imagPart) + "i"
+			return class.sourceFromFloat(float)
 		}
-	}
-	return source
+	})
+}
+
+func (v number_) AsEngineering() string {
+	return numberClass().FormatEngineering(v)
 }
 
 func (v number_) AsPolar() string {
-	var source string
-	switch {
-	case v.IsZero():
-		source = "0"
-	case v.IsMinimum():
-		source = "-∞"
-	case v.IsMaximum():
-		source = "+∞"
-	case v.IsInfinite():
-		source = "∞"
-	case !v.IsDefined():
-		source = "undefined"
-	default:
-		var magnitude = v.GetMagnitude()
-		var angle = v.GetAngle()
-		source = numberClass().sourceFromFloat(magnitude)
-		if angle > 0 {
-			source += "e^~" + numberClass().sourceFromFloat(angle) + "i"
-		}
-	}
-	return source
+	return numberClass().polarSource(v)
 }
 
 func (v number_) GetReal() float64 {
@@ -461,6 +833,20 @@ func (v number_) AsFloat() float64 {
 	return real(v)
 }
 
+func (v number_) AsBigFloat() *big.Float {
+	if !v.isFiniteReal() {
+		return nil
+	}
+	return big.NewFloat(real(v))
+}
+
+func (v number_) AsBigRat() *big.Rat {
+	if !v.isFiniteReal() {
+		return nil
+	}
+	return new(big.Rat).SetFloat64(real(v)) // This is exact.
+}
+
 func (v number_) HasMagnitude() bool {
 	return v.IsDefined() && !v.IsZero() && !v.IsMinimum() &&
 		!v.IsMaximum() && !v.IsInfinite()
@@ -492,12 +878,202 @@ func (v number_) IsNegative() bool {
 	return real(v) < 0
 }
 
+// Ordered[NumberLike] Methods
+
+func (v number_) IsBefore(
+	value NumberLike,
+) bool {
+	return numberClass().Compare(v, value) < 0
+}
+
 // PROTECTED INTERFACE
 
 func (v number_) String() string {
 	return v.AsSource()
 }
 
+func (v number_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *number_) UnmarshalText(
+	text []byte,
+) error {
+	var number, err = numberClass().ParseNumber(string(text))
+	if err != nil {
+		return err
+	}
+	*v = number.(number_)
+	return nil
+}
+
+func (v number_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *number_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v number_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	if v.IsDefined() && !v.IsInfinite() {
+		if v.GetImaginary() == 0 {
+			return jsn.Marshal(v.GetReal())
+		}
+		return jsn.Marshal([]float64{v.GetReal(), v.GetImaginary()})
+	}
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *number_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	if !jsonClass().isString(bytes) {
+		var real_ float64
+		var err = jsn.Unmarshal(bytes, &real_)
+		if err == nil {
+			*v = numberClass().Number(complex(real_, 0)).(number_)
+			return nil
+		}
+		var parts []float64
+		err = jsn.Unmarshal(bytes, &parts)
+		if err != nil {
+			return err
+		}
+		if len(parts) != 2 {
+			return fmt.Errorf("A complex number must have exactly two parts: %v", parts)
+		}
+		*v = numberClass().Number(complex(parts[0], parts[1])).(number_)
+		return nil
+	}
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v number_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = formatClass().Header(NumberType)
+	bytes = formatClass().appendFloat(bytes, v.GetReal())
+	if v.GetImaginary() != 0 {
+		bytes = formatClass().appendFloat(bytes, v.GetImaginary())
+	}
+	return
+}
+
+func (v *number_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = formatClass().Payload(NumberType, bytes)
+	if err != nil {
+		return err
+	}
+	var floats []float64
+	if len(payload) == 8 {
+		floats, err = formatClass().floatsFromPayload(payload, 1)
+		floats = append(floats, 0)
+	} else {
+		floats, err = formatClass().floatsFromPayload(payload, 2)
+	}
+	if err != nil {
+		return err
+	}
+	*v = numberClass().Number(complex(floats[0], floats[1])).(number_)
+	return nil
+}
+
+func (v number_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	if v.GetImaginary() == 0 {
+		return cborClass().Encode(0, v.GetReal())
+	}
+	return cborClass().Encode(
+		numberClass().cborTag_,
+		[]any{v.GetReal(), v.GetImaginary()},
+	)
+}
+
+func (v *number_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = cborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != 0 && tag != numberClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not a number: %x", bytes)
+	}
+	if tag == 0 {
+		var real_, failure = cborClass().floatFromValue(value)
+		if failure != nil {
+			return failure
+		}
+		*v = numberClass().Number(complex(real_, 0)).(number_)
+		return nil
+	}
+	var parts, _ = value.([]any)
+	if len(parts) != 2 {
+		return fmt.Errorf("A complex number must have exactly two parts: %v", value)
+	}
+	var real_, failure = cborClass().floatFromValue(parts[0])
+	if failure != nil {
+		return failure
+	}
+	var imaginary float64
+	imaginary, failure = cborClass().floatFromValue(parts[1])
+	if failure != nil {
+		return failure
+	}
+	*v = numberClass().Number(complex(real_, imaginary)).(number_)
+	return nil
+}
+
+func (v number_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *number_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a number: %v",
+			source,
+		)
+	}
+}
+
 // Private Methods
 
 // This private function returns the complex number associated with the
@@ -534,6 +1110,95 @@ func (c *numberClass_) complexFromMatches(matches []string) complex128 {
 	return complex_
 }
 
+// NOTE:
+// This private function uses the Lanczos approximation to calculate the gamma
+// function for a complex number that does not lie on the real axis.  The
+// reflection formula is used for complex numbers in the left half plane.
+//   - https://en.wikipedia.org/wiki/Lanczos_approximation
+func (c *numberClass_) complexGamma(complex_ complex128) complex128 {
+	if real(complex_) < 0.5 {
+		// Γ(z) => π / (sin(πz)Γ(1-z))
+		var sine = c.Sine(c.normalize(mat.Pi * complex_)).AsIntrinsic()
+		return mat.Pi / (sine * c.complexGamma(1-complex_))
+	}
+	complex_--
+	var coefficients = c.lanczos_
+	var sum = complex(coefficients[0], 0)
+	for index := 1; index < len(coefficients); index++ {
+		sum += complex(coefficients[index], 0) / (complex_ + complex(float64(index), 0))
+	}
+	var t = complex_ + complex(float64(len(coefficients))-1.5, 0)
+	var root = complex(mat.Sqrt(2.0*mat.Pi), 0)
+	return root * cmp.Pow(t, complex_+0.5) * cmp.Exp(-t) * sum
+}
+
+// NOTE:
+// This private function returns the key that determines the total ordering of
+// the specified number: its rank, magnitude and then angle in the range
+// [0..τ).  An undefined number has the lowest rank, followed by zero and the
+// finite numbers, then positive infinity, negative infinity and finally the
+// complex infinity.
+func (c *numberClass_) orderingKey(number NumberLike) [3]float64 {
+	switch {
+	case !number.IsDefined():
+		return [3]float64{0, 0, 0}
+	case number.IsMaximum():
+		return [3]float64{2, 0, 0}
+	case number.IsMinimum():
+		return [3]float64{3, 0, 0}
+	case number.IsInfinite():
+		return [3]float64{4, 0, 0}
+	default:
+		var angle = number.GetAngle()
+		if angle < 0 {
+			angle += 2.0 * mat.Pi
+		}
+		return [3]float64{1, cmp.Abs(number.AsIntrinsic()), angle}
+	}
+}
+
+// NOTE:
+// This private function returns the engineering notation for the specified
+// floating point value.  The decimal point is shifted so that the exponent is a
+// multiple of three, and that exponent is replaced with its metric prefix (e.g.
+// "4.7k" or "10µ").  The significant digits follow the precision policy of the
+// class.  A value that has no metric prefix—including those in the exa range,
+// whose "E" prefix would be mistaken for an exponent—uses the decimal source.
+func (c *numberClass_) engineeringFromFloat(float float64) string {
+	var digits = 14
+	switch c.GetPrecision() {
+	case ExactPrecision:
+		digits = -1
+	case SignificantPrecision:
+		digits = int(c.GetSignificantDigits()) - 1
+	}
+	var scientific = stc.FormatFloat(mat.Abs(float), 'e', digits, 64)
+	var mantissa, power, _ = sts.Cut(scientific, "e")
+	var exponent, _ = stc.Atoi(power)
+	var index = exponent / 3
+	if exponent < 0 && exponent%3 != 0 {
+		index--
+	}
+	var position = index + len(c.metricPrefixes_)/2
+	if position < 0 || position >= len(c.metricPrefixes_) ||
+		(index != 0 && len(c.metricPrefixes_[position]) == 0) {
+		return c.sourceFromFloat(float)
+	}
+	var shift = exponent - 3*index
+	mantissa = sts.TrimRight(sts.Replace(mantissa, ".", "", 1), "0")
+	for len(mantissa) <= shift {
+		mantissa += "0"
+	}
+	var source = mantissa[:shift+1]
+	if len(mantissa) > shift+1 {
+		source += "." + mantissa[shift+1:]
+	}
+	if float < 0 {
+		source = "-" + source
+	}
+	return source + c.metricPrefixes_[position]
+}
+
 // This private function returns the floating point value for the specified
 // string.
 func (c *numberClass_) floatFromSource(source string) float64 {
@@ -562,49 +1227,99 @@ func (c *numberClass_) floatFromSource(source string) float64 {
 	case "undefined":
 		float = mat.NaN()
 	default:
-		float, _ = stc.ParseFloat(source, 64)
+		var digits = sts.TrimLeft(source, "+-")
+		var metric, size = utf.DecodeLastRuneInString(source)
+		var power = sli.Index(c.metricPrefixes_, string(metric))
+		switch {
+		case sts.HasPrefix(digits, "0x"), sts.HasPrefix(digits, "0o"),
+			sts.HasPrefix(digits, "0b"):
+			// The math/big parser handles all of the radix prefixes and rounds
+			// to the nearest float64 value.
+			var radix, _, _ = big.ParseFloat(source, 0, 53, big.ToNearestEven)
+			float, _ = radix.Float64()
+		case metric == 'μ':
+			// The Greek letter mu is accepted in place of the micro sign.
+			power = sli.Index(c.metricPrefixes_, "µ")
+			fallthrough
+		case power >= 0 && len(c.metricPrefixes_[power]) > 0:
+			var exponent = 3 * (power - len(c.metricPrefixes_)/2)
+			var scaled = source[:len(source)-size] + "E" + stc.Itoa(exponent)
+			float, _ = stc.ParseFloat(scaled, 64)
+		default:
+			float, _ = stc.ParseFloat(source, 64)
+		}
 	}
 	return float
 }
 
 // NOTE:
-// This private function uses the single precision floating point range to lock
-// a double precision floating point value onto 0, 1, -1, or ∞ when the
-// floating point value falls outside the single precision range for these
-// values. Otherwise, the floating point value is returned unchanged.
+// This private function applies the precision policy of the class to a double
+// precision floating point value.  The locked precision uses the single
+// precision floating point range to lock the value onto 0, 1, -1, or ∞ when it
+// falls outside the single precision range for these values.  The significant
+// precision rounds the value to the significant digits of the class, and the
+// exact precision returns the value unchanged.
 func (c *numberClass_) lockFloat(float float64) float64 {
-	var limited = float32(float)
-	switch {
-	case mat.Abs(float) <= 1.2246467991473515e-16:
-		// We must handle round-off errors for some trigonometric functions.
-		float = 0
-	case limited == -1:
-		float = -1
-	case limited == 1:
-		float = 1
-	case mat.IsInf(float, 0):
-		float = mat.Inf(1)
+	if mat.IsInf(float, 0) {
+		return mat.Inf(1)
+	}
+	switch c.GetPrecision() {
+	case ExactPrecision:
+		// The double precision value is already exact.
+	case SignificantPrecision:
+		float = c.roundFloat(float)
+	default:
+		var limited = float32(float)
+		switch {
+		case mat.Abs(float) <= 1.2246467991473515e-16:
+			// We must handle round-off errors for some trigonometric functions.
+			float = 0
+		case limited == -1:
+			float = -1
+		case limited == 1:
+			float = 1
+		}
 	}
 	return float
 }
 
 // NOTE:
-// This private function uses the single precision floating point range to lock
-// a double precision floating point angle onto 0, π/2, π, or 3π/2 when the
-// angle falls outside of the single precision range for these values.
-// Otherwise, the angle is returned unchanged.
+// This private function applies the precision policy of the class to a double
+// precision floating point angle.  The locked precision uses the single
+// precision floating point range to lock the angle onto 0, π/2, π, or 3π/2
+// when it falls outside of the single precision range for these values.  The
+// significant precision locks the angle onto these values when it matches them
+// to the significant digits of the class and otherwise rounds it to those
+// digits.  The exact precision returns the angle unchanged.
 func (c *numberClass_) lockAngle(angle float64) float64 {
-	var angle32 = float32(angle)
-	switch {
-	case mat.Abs(angle) <= 1.2246467991473515e-16:
-		// We must handle round-off errors for some trigonometric functions.
-		angle = 0
-	case angle32 == float32(0.5*mat.Pi):
-		angle = 0.5 * mat.Pi
-	case angle32 == float32(mat.Pi):
-		angle = mat.Pi
-	case angle32 == float32(1.5*mat.Pi):
-		angle = 1.5 * mat.Pi
+	switch c.GetPrecision() {
+	case ExactPrecision:
+		// The double precision angle is already exact.
+	case SignificantPrecision:
+		var rounded = c.roundFloat(angle)
+		switch rounded {
+		case c.roundFloat(0.5 * mat.Pi):
+			angle = 0.5 * mat.Pi
+		case c.roundFloat(mat.Pi):
+			angle = mat.Pi
+		case c.roundFloat(1.5 * mat.Pi):
+			angle = 1.5 * mat.Pi
+		default:
+			angle = rounded
+		}
+	default:
+		var angle32 = float32(angle)
+		switch {
+		case mat.Abs(angle) <= 1.2246467991473515e-16:
+			// We must handle round-off errors for some trigonometric functions.
+			angle = 0
+		case angle32 == float32(0.5*mat.Pi):
+			angle = 0.5 * mat.Pi
+		case angle32 == float32(mat.Pi):
+			angle = mat.Pi
+		case angle32 == float32(1.5*mat.Pi):
+			angle = 1.5 * mat.Pi
+		}
 	}
 	return angle
 }
@@ -636,12 +1351,111 @@ func (c *numberClass_) normalize(complex_ complex128) NumberLike {
 	return number
 }
 
+// This private function returns the polar source form of the specified number
+// using the precision policy of the class.
+func (c *numberClass_) polarSource(number number_) string {
+	var source string
+	switch {
+	case number.IsZero():
+		source = "0"
+	case number.IsMinimum():
+		source = "-∞"
+	case number.IsMaximum():
+		source = "+∞"
+	case number.IsInfinite():
+		source = "∞"
+	case !number.IsDefined():
+		source = "undefined"
+	default:
+		var magnitude = c.lockFloat(cmp.Abs(complex128(number)))
+		var angle = number.GetAngle()
+		source = c.sourceFromFloat(magnitude)
+		if angle > 0 {
+			source += "e^~" + c.sourceFromFloat(angle) + "i"
+		}
+	}
+	return source
+}
+
+// This private function returns the complex number with the specified
+// magnitude and angle, using the exact sine and cosine of any multiple of π/4.
+func (c *numberClass_) rectangularFromPolar(
+	magnitude float64,
+	angle float64,
+) complex128 {
+	var sine, cosine = c.sineAndCosine(angle)
+	return complex(c.scaled(magnitude, cosine), c.scaled(magnitude, sine))
+}
+
+// This private function returns the product of the specified floating point
+// values, treating zero times infinity as zero rather than as undefined.
+func (c *numberClass_) scaled(
+	float float64,
+	factor float64,
+) float64 {
+	if float == 0 || factor == 0 {
+		return 0
+	}
+	return float * factor
+}
+
+// This private function returns the sine and cosine of the specified angle.
+// Unless the precision of the class is exact, the angle is first normalized and
+// locked so that any multiple of π/2 has an exact sine and cosine.
+func (c *numberClass_) sineAndCosine(
+	angle float64,
+) (
+	sine float64,
+	cosine float64,
+) {
+	if c.GetPrecision() == ExactPrecision {
+		sine, cosine = mat.Sincos(angle)
+		return
+	}
+	var tau = 2.0 * mat.Pi
+	angle = mat.Mod(angle, tau)
+	if angle < 0 {
+		angle += tau
+	}
+	switch c.lockAngle(angle) {
+	case 0, tau:
+		sine, cosine = 0, 1
+	case 0.5 * mat.Pi:
+		sine, cosine = 1, 0
+	case mat.Pi:
+		sine, cosine = 0, -1
+	case 1.5 * mat.Pi:
+		sine, cosine = -1, 0
+	default:
+		sine, cosine = mat.Sincos(angle)
+	}
+	return
+}
+
+// This private function rounds the specified floating point value to the
+// significant digits of the class.
+func (c *numberClass_) roundFloat(float float64) float64 {
+	var digits = int(c.GetSignificantDigits())
+	var rounded, _ = stc.ParseFloat(stc.FormatFloat(float, 'g', digits, 64), 64)
+	return rounded
+}
+
 func (c *numberClass_) sourceFromFloat(float float64) string {
-	var float63 = mat.Float64frombits(mat.Float64bits(float) &^ 1)
-	var e = mat.Float64frombits(mat.Float64bits(mat.E) &^ 1)
-	var pi = mat.Float64frombits(mat.Float64bits(mat.Pi) &^ 1)
-	var tau = mat.Float64frombits(mat.Float64bits(2.0*mat.Pi) &^ 1)
-	var phi = mat.Float64frombits(mat.Float64bits(mat.Phi) &^ 1)
+	var mask uint64 = 1
+	var digits = 15
+	switch c.GetPrecision() {
+	case ExactPrecision:
+		// Render the shortest source that parses back to the exact value.
+		mask = 0
+		digits = -1
+	case SignificantPrecision:
+		digits = int(c.GetSignificantDigits())
+	}
+	var float63 = mat.Float64frombits(mat.Float64bits(float) &^ mask)
+	var e = mat.Float64frombits(mat.Float64bits(mat.E) &^ mask)
+	var pi = mat.Float64frombits(mat.Float64bits(mat.Pi) &^ mask)
+	var tau = mat.Float64frombits(mat.Float64bits(2.0*mat.Pi) &^ mask)
+	var phi = mat.Float64frombits(mat.Float64bits(mat.Phi) &^ mask)
 	var source string
 	switch {
 	case float63 == e:
@@ -667,7 +1481,49 @@ func (c *numberClass_) sourceFromFloat(float float64) string {
 	case mat.IsNaN(float):
 		source = "undefined"
 	default:
-		source = stc.FormatFloat(float63, 'G', 15, 64)
+		source = stc.FormatFloat(float63, 'G', digits, 64)
+	}
+	return source
+}
+
+// This private method determines whether or not the number is a finite real
+// number, the only kind of number that math/big can represent.
+func (v number_) isFiniteReal() bool {
+	return imag(v) == 0 && v.IsDefined() && !v.IsInfinite()
+}
+
+// This private method returns the rectangular source for the number, using the
+// specified function to render each of its non-zero parts.
+func (v number_) rectangularSource(
+	format func(float64) string,
+) string {
+	var source string
+	switch {
+	case v.IsZero():
+		source = "0"
+	case v.IsMinimum():
+		source = "-∞"
+	case v.IsMaximum():
+		source = "+∞"
+	case v.IsInfinite():
+		source = "∞"
+	case !v.IsDefined():
+		source = "undefined"
+	default:
+		var realPart = v.GetReal()
+		var imagPart = v.GetImaginary()
+		switch {
+		case imagPart == 0:
+			source = format(realPart)
+		case realPart == 0:
+			source = format(imagPart) + "i"
+		default:
+			source = format(realPart)
+			if imagPart > 0 {
+				source += "+"
+			}
+			source += format(imagPart) + "i"
+		}
 	}
 	return source
 }
@@ -686,12 +1542,16 @@ const (
 	exponent_       = "E(?:" + sign_ + ")?" + ordinal_
 	float_          = "(?:" + sign_ + ")?" + amplitude_
 	fraction_       = "\\.(?:" + base10_ + ")+"
-	imaginary_      = float_ + "i"
+	imaginary_      = "(?:" + sign_ + ")?" + numeral_ + "i"
 	infinity_       = "(?:" + sign_ + ")?(?:infinity|∞)"
+	metric_         = "[qryzafpnµμmkMGTPZYRQ]"
+	numeral_        = "(?:" + radix_ + "|" + scaled_ + "|" + amplitude_ + ")"
 	ordinal_        = "[1-9](?:" + base10_ + ")*"
-	polar_          = "(" + amplitude_ + ")e\\^~(" + amplitude_ + ")i"
-	real_           = float_ + "|0|" + infinity_ + "|" + undefined_
-	rectangular_    = "((?:" + sign_ + ")?" + amplitude_ + ")((?:" + sign_ + ")" + amplitude_ + ")i"
+	polar_          = "(" + numeral_ + ")e\\^~(" + numeral_ + ")i"
+	radix_          = "0x(?:" + radix16_ + ")+(?:\\.(?:" + radix16_ + ")+)?(?:p(?:" + sign_ + ")?(?:" + base10_ + ")+)?|0o(?:" + radix8_ + ")+|0b(?:" + radix2_ + ")+"
+	rectangular_    = "((?:" + sign_ + ")?" + numeral_ + ")((?:" + sign_ + ")" + numeral_ + ")i"
+	scalar_         = "(?:" + sign_ + ")?" + numeral_ + "|0|" + infinity_ + "|" + undefined_
+	scaled_         = "(?:0" + fraction_ + "|" + ordinal_ + "(?:" + fraction_ + ")?)" + metric_
 	sign_           = "\\+|-"
 	transcendental_ = "e|pi|π|tau|τ|phi|φ"
 	undefined_      = "undefined"
@@ -705,18 +1565,25 @@ type number_ complex128
 
 type numberClass_ struct {
 	// Declare the class constants.
-	matcher_   *reg.Regexp
-	undefined_ NumberLike
-	zero_      NumberLike
-	one_       NumberLike
-	i_         NumberLike
-	e_         NumberLike
-	pi_        NumberLike
-	tau_       NumberLike
-	phi_       NumberLike
-	minimum_   NumberLike
-	maximum_   NumberLike
-	infinity_  NumberLike
+	matcher_        *reg.Regexp
+	productions_    map[int]string
+	undefined_      NumberLike
+	zero_           NumberLike
+	one_            NumberLike
+	i_              NumberLike
+	e_              NumberLike
+	pi_             NumberLike
+	tau_            NumberLike
+	phi_            NumberLike
+	minimum_        NumberLike
+	maximum_        NumberLike
+	infinity_       NumberLike
+	lanczos_        []float64
+	radixPrefixes_  map[uint]string
+	metricPrefixes_ []string
+	precision_      Precision
+	digits_         uint
+	cborTag_        uint64
 }
 
 // Class Reference
@@ -728,8 +1595,16 @@ func numberClass() *numberClass_ {
 var numberClassReference_ = &numberClass_{
 	// Initialize the class constants.
 	matcher_: reg.MustCompile(
-		"^(" + polar_ + ")|(" + rectangular_ + ")|(" + imaginary_ + ")|(" + real_ + ")",
+		"^(?:(" + polar_ + ")|(" + rectangular_ + ")|(" + imaginary_ + ")|(" + scalar_ + "))",
 	),
+	productions_: map[int]string{
+		2: "magnitude",
+		3: "angle",
+		5: "real part",
+		6: "imaginary part",
+		7: "imaginary number",
+		8: "real number",
+	},
 	undefined_: number_(complex(mat.NaN(), mat.NaN())),
 	zero_:      number_(0),
 	one_:       number_(1.0),
@@ -741,4 +1616,28 @@ var numberClassReference_ = &numberClass_{
 	minimum_:   number_(complex(mat.Inf(-1), 0)),
 	maximum_:   number_(complex(mat.Inf(1), 0)),
 	infinity_:  number_(complex(mat.Inf(0), mat.Inf(0))),
+	lanczos_: []float64{
+		0.99999999999980993,
+		676.5203681218851,
+		-1259.1392167224028,
+		771.32342877765313,
+		-176.61502916214059,
+		12.507343278686905,
+		-0.13857109526572012,
+		9.9843695780195716e-6,
+		1.5056327351493116e-7,
+	},
+	radixPrefixes_: map[uint]string{
+		2:  "0b",
+		8:  "0o",
+		16: "0x",
+	},
+	metricPrefixes_: []string{
+		"q", "r", "y", "z", "a", "f", "p", "n", "µ", "m",
+		"",
+		"k", "M", "G", "T", "P", "", "Z", "Y", "R", "Q",
+	},
+	precision_: LockedPrecision,
+	digits_:    15,
+	cborTag_:   0x63646706,
 }
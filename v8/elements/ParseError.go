@@ -0,0 +1,400 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package elements
+
+import (
+	fmt "fmt"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
+	reg "regexp"
+	syn "regexp/syntax"
+	sli "slices"
+	stc "strconv"
+	sts "strings"
+	utf "unicode/utf8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ParseErrorClass() ParseErrorClassLike {
+	return parseErrorClass()
+}
+
+// Constructor Methods
+
+func (c *parseErrorClass_) ParseError(
+	kind string,
+	source string,
+	offset uint,
+	expected string,
+) ParseErrorLike {
+	if offset > uint(len(source)) {
+		offset = uint(len(source))
+	}
+	var parseError = &parseError_{
+		kind_:     kind,
+		source_:   source,
+		offset_:   offset,
+		expected_: expected,
+	}
+	return parseError
+}
+
+func (c *parseErrorClass_) ParseErrorFromMatcher(
+	kind string,
+	matcher *reg.Regexp,
+	productions map[int]string,
+	source string,
+) ParseErrorLike {
+	var offset, expected = c.simulateMatcher(matcher, productions, source)
+	return c.ParseError(kind, source, offset, expected)
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *parseError_) GetClass() ParseErrorClassLike {
+	return parseErrorClass()
+}
+
+func (v *parseError_) Error() string {
+	return fmt.Sprintf(
+		"An illegal string was passed to the %s constructor method, expected %s at line %v, column %v: %s",
+		v.kind_,
+		v.expected_,
+		v.GetLine(),
+		v.GetColumn(),
+		v.source_,
+	)
+}
+
+// Attribute Methods
+
+func (v *parseError_) GetKind() string {
+	return v.kind_
+}
+
+func (v *parseError_) GetSource() string {
+	return v.source_
+}
+
+func (v *parseError_) GetOffset() uint {
+	return v.offset_
+}
+
+func (v *parseError_) GetPrefix() string {
+	return v.source_[:v.offset_]
+}
+
+func (v *parseError_) GetLine() uint {
+	var prefix = v.GetPrefix()
+	return uint(sts.Count(prefix, "\n")) + 1
+}
+
+func (v *parseError_) GetColumn() uint {
+	var prefix = v.GetPrefix()
+	var line = prefix[sts.LastIndex(prefix, "\n")+1:]
+	return uint(utf.RuneCountInString(line)) + 1
+}
+
+func (v *parseError_) GetExpected() string {
+	return v.expected_
+}
+
+// PROTECTED INTERFACE
+
+func (v *parseError_) String() string {
+	return v.Error()
+}
+
+// Private Methods
+
+// NOTE:
+// The Go regexp package only tells us whether or not a string matched, not how
+// far into the string the match got before it failed.  So this private method
+// compiles the matcher into its underlying program and steps through the source
+// string one character at a time, tracking every thread that is still alive
+// along with the capture groups each thread is currently inside of.  The offset
+// of the first character that kills off all remaining threads is where the
+// matcher failed.  If the threads survive the entire source string then the
+// source string ended prematurely.  Either way, the surviving threads tell us
+// what was expected at that point.
+func (c *parseErrorClass_) simulateMatcher(
+	matcher *reg.Regexp,
+	productions map[int]string,
+	source string,
+) (
+	offset uint,
+	expected string,
+) {
+	var expression, err = syn.Parse(matcher.String(), syn.Perl)
+	if err != nil {
+		panic(fmt.Sprintf("The matcher could not be parsed: %v", err))
+	}
+	var program *syn.Prog
+	program, err = syn.Compile(expression.Simplify())
+	if err != nil {
+		panic(fmt.Sprintf("The matcher could not be compiled: %v", err))
+	}
+
+	// Start a thread at the beginning of the source string.
+	var index int
+	var current = c.nextRune(source, index)
+	var context = syn.EmptyOpContext(-1, current)
+	var visited = make([]bool, len(program.Inst))
+	var start = thread_{pc: uint32(program.Start)}
+	var threads = c.addThread(program, nil, start, context, visited)
+
+	// Advance the threads one character at a time.
+	for index < len(source) {
+		var _, size = utf.DecodeRuneInString(source[index:])
+		var next = c.nextRune(source, index+size)
+		context = syn.EmptyOpContext(current, next)
+		visited = make([]bool, len(program.Inst))
+		var survivors []thread_
+		for _, thread := range threads {
+			var instruction = program.Inst[thread.pc]
+			if c.matchesRune(instruction, current) {
+				thread.pc = instruction.Out
+				survivors = c.addThread(program, survivors, thread, context, visited)
+			}
+		}
+		if len(survivors) == 0 {
+			// None of the threads could consume the current character.
+			break
+		}
+		threads = survivors
+		index += size
+		current = next
+	}
+	offset = uint(index)
+	expected = c.describeThreads(program, productions, threads)
+	return
+}
+
+// This private method adds the specified thread to the list of threads,
+// following all instructions that do not consume a character.
+func (c *parseErrorClass_) addThread(
+	program *syn.Prog,
+	threads []thread_,
+	thread thread_,
+	context syn.EmptyOp,
+	visited []bool,
+) []thread_ {
+	if visited[thread.pc] {
+		return threads
+	}
+	visited[thread.pc] = true
+	var instruction = program.Inst[thread.pc]
+	switch instruction.Op {
+	case syn.InstAlt, syn.InstAltMatch:
+		var alternative = thread
+		thread.pc = instruction.Out
+		threads = c.addThread(program, threads, thread, context, visited)
+		alternative.pc = instruction.Arg
+		threads = c.addThread(program, threads, alternative, context, visited)
+	case syn.InstCapture:
+		var group = int(instruction.Arg / 2)
+		var groups = make([]int, 0, len(thread.groups)+1)
+		if instruction.Arg%2 == 0 {
+			// The thread is entering the capture group.
+			groups = append(groups, thread.groups...)
+			groups = append(groups, group)
+		} else {
+			// The thread is leaving the capture group.
+			for _, open := range thread.groups {
+				if open != group {
+					groups = append(groups, open)
+				}
+			}
+		}
+		thread.groups = groups
+		thread.pc = instruction.Out
+		threads = c.addThread(program, threads, thread, context, visited)
+	case syn.InstNop:
+		thread.pc = instruction.Out
+		threads = c.addThread(program, threads, thread, context, visited)
+	case syn.InstEmptyWidth:
+		if syn.EmptyOp(instruction.Arg)&^context == 0 {
+			thread.pc = instruction.Out
+			threads = c.addThread(program, threads, thread, context, visited)
+		}
+	case syn.InstFail:
+		// This thread dies here.
+	default:
+		// The instruction consumes a character (or is a match).
+		threads = append(threads, thread)
+	}
+	return threads
+}
+
+// This private method describes what the specified threads were expecting
+// next.  Each thread is described by the innermost capture group it is inside
+// of that has a production description, or failing that, by the characters its
+// next instruction accepts.
+func (c *parseErrorClass_) describeThreads(
+	program *syn.Prog,
+	productions map[int]string,
+	threads []thread_,
+) string {
+	var descriptions []string
+	for _, thread := range threads {
+		var description string
+		for index := len(thread.groups) - 1; index >= 0; index-- {
+			var production, ok = productions[thread.groups[index]]
+			if ok {
+				description = production
+				break
+			}
+		}
+		if len(description) == 0 {
+			description = c.describeInstruction(program.Inst[thread.pc])
+		}
+		if len(description) > 0 && !sli.Contains(descriptions, description) {
+			descriptions = append(descriptions, description)
+		}
+	}
+	if len(descriptions) == 0 {
+		return "nothing more"
+	}
+	return sts.Join(descriptions, " or ")
+}
+
+func (c *parseErrorClass_) describeInstruction(
+	instruction syn.Inst,
+) string {
+	var description string
+	switch instruction.Op {
+	case syn.InstRune1:
+		description = stc.Quote(string(instruction.Rune[0]))
+	case syn.InstRune:
+		var ranges = instruction.Rune
+		if len(ranges) > 8 {
+			// Large character classes are not worth spelling out.
+			description = "a legal character"
+			break
+		}
+		var builder sts.Builder
+		builder.WriteString("[")
+		for index := 0; index < len(ranges); index += 2 {
+			builder.WriteString(c.escapeRune(ranges[index]))
+			if ranges[index+1] != ranges[index] {
+				builder.WriteString("-")
+				builder.WriteString(c.escapeRune(ranges[index+1]))
+			}
+		}
+		builder.WriteString("]")
+		description = builder.String()
+	case syn.InstRuneAny, syn.InstRuneAnyNotNL:
+		description = "any character"
+	case syn.InstMatch:
+		description = "the end of the source"
+	}
+	return description
+}
+
+func (c *parseErrorClass_) escapeRune(
+	rune_ rune,
+) string {
+	var quoted = stc.QuoteRune(rune_)
+	return quoted[1 : len(quoted)-1] // Strip off the single quotes.
+}
+
+func (c *parseErrorClass_) matchesRune(
+	instruction syn.Inst,
+	rune_ rune,
+) bool {
+	switch instruction.Op {
+	case syn.InstRune, syn.InstRune1:
+		return instruction.MatchRune(rune_)
+	case syn.InstRuneAny:
+		return true
+	case syn.InstRuneAnyNotNL:
+		return rune_ != '\n'
+	default:
+		return false
+	}
+}
+
+func (c *parseErrorClass_) nextRune(
+	source string,
+	index int,
+) rune {
+	if index >= len(source) {
+		return -1
+	}
+	var rune_, _ = utf.DecodeRuneInString(source[index:])
+	return rune_
+}
+
+// This private structure tracks a single thread of execution through the
+// program for a matcher.
+type thread_ struct {
+	pc     uint32
+	groups []int
+}
+
+// Instance Structure
+
+type parseError_ struct {
+	// Declare the instance attributes.
+	kind_     string
+	source_   string
+	offset_   uint
+	expected_ string
+}
+
+// Class Structure
+
+type parseErrorClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func parseErrorClass() *parseErrorClass_ {
+	return parseErrorClassReference_
+}
+
+var parseErrorClassReference_ = &parseErrorClass_{
+	// Initialize the class constants.
+}
+
+// NOTE:
+// The scanner in the sequences package looks up the matcher for each kind of
+// element in the internal grammar package, so they are registered here once
+// every class reference has been initialized.
+func init() {
+	gra.RegisterMatchers(map[string]*reg.Regexp{
+		"angle":       angleClass().matcher_,
+		"boolean":     booleanClass().matcher_,
+		"decimal":     decimalClass().matcher_,
+		"duration":    durationClass().matcher_,
+		"glyph":       glyphClass().matcher_,
+		"integer":     integerClass().matcher_,
+		"interval":    intervalClass().matcher_,
+		"moment":      momentClass().matcher_,
+		"number":      numberClass().matcher_,
+		"percentage":  percentageClass().matcher_,
+		"probability": probabilityClass().matcher_,
+		"rational":    rationalClass().matcher_,
+		"recurrence":  recurrenceClass().matcher_,
+		"resource":    resourceClass().matcher_,
+	})
+}
@@ -13,7 +13,11 @@
 package elements
 
 import (
+	cmp "cmp"
+	drv "database/sql/driver"
+	jsn "encoding/json"
 	fmt "fmt"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	mat "math"
 	reg "regexp"
@@ -46,16 +50,32 @@ func (c *percentageClass_) PercentageFromInteger(
 func (c *percentageClass_) PercentageFromSource(
 	source string,
 ) PercentageLike {
-	var matches = c.matcher_.FindStringSubmatch(source)
+	var percentage, err = c.ParsePercentage(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return percentage
+}
+
+func (c *percentageClass_) ParsePercentage(
+	source string,
+) (
+	percentage PercentageLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
 	if uti.IsUndefined(matches) {
-		var message = fmt.Sprintf(
-			"An illegal string was passed to the percentage constructor method: %s",
+		err = parseErrorClass().ParseErrorFromMatcher(
+			"percentage",
+			c.matcher_,
+			c.productions_,
 			source,
 		)
-		panic(message)
+		return
 	}
 	var float, _ = stc.ParseFloat(matches[1], 64) // Strip off the '%' suffix.
-	return percentage_(float / 100.0)
+	percentage = percentage_(float / 100.0)
+	return
 }
 
 // Constant Methods
@@ -66,6 +86,27 @@ func (c *percentageClass_) Undefined() PercentageLike {
 
 // Function Methods
 
+func (c *percentageClass_) Compare(
+	first PercentageLike,
+	second PercentageLike,
+) int {
+	return cmp.Compare(first.AsIntrinsic(), second.AsIntrinsic())
+}
+
+func (c *percentageClass_) ApproximatelyEqual(
+	first PercentageLike,
+	second PercentageLike,
+	tolerance PercentageLike,
+) bool {
+	var firstFloat = first.AsIntrinsic()
+	var secondFloat = second.AsIntrinsic()
+	if firstFloat == secondFloat {
+		// This also handles matching infinities.
+		return true
+	}
+	return mat.Abs(firstFloat-secondFloat) <= tolerance.AsIntrinsic()
+}
+
 // INSTANCE INTERFACE
 
 // Principal Methods
@@ -120,12 +161,160 @@ func (v percentage_) IsNegative() bool {
 	return v < 0
 }
 
+// Ordered[PercentageLike] Methods
+
+func (v percentage_) IsBefore(
+	value PercentageLike,
+) bool {
+	return percentageClass().Compare(v, value) < 0
+}
+
 // PROTECTED INTERFACE
 
 func (v percentage_) String() string {
 	return v.AsSource()
 }
 
+func (v percentage_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *percentage_) UnmarshalText(
+	text []byte,
+) error {
+	var percentage, err = percentageClass().ParsePercentage(string(text))
+	if err != nil {
+		return err
+	}
+	*v = percentage.(percentage_)
+	return nil
+}
+
+func (v percentage_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *percentage_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v percentage_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	var float = v.AsFloat()
+	if !mat.IsNaN(float) && !mat.IsInf(float, 0) {
+		return jsn.Marshal(float)
+	}
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *percentage_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	if !jsonClass().isString(bytes) {
+		var float float64
+		var err = jsn.Unmarshal(bytes, &float)
+		if err != nil {
+			return err
+		}
+		*v = percentageClass().Percentage(float).(percentage_)
+		return nil
+	}
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v percentage_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = formatClass().Header(PercentageType)
+	bytes = formatClass().appendFloat(bytes, v.AsIntrinsic())
+	return
+}
+
+func (v *percentage_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = formatClass().Payload(PercentageType, bytes)
+	if err != nil {
+		return err
+	}
+	var floats []float64
+	floats, err = formatClass().floatsFromPayload(payload, 1)
+	if err != nil {
+		return err
+	}
+	*v = percentage_(floats[0])
+	return nil
+}
+
+func (v percentage_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	return cborClass().Encode(percentageClass().cborTag_, v.AsIntrinsic())
+}
+
+func (v *percentage_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = cborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != percentageClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not a percentage: %x", bytes)
+	}
+	var float, failure = cborClass().floatFromValue(value)
+	if failure != nil {
+		return failure
+	}
+	*v = percentage_(float)
+	return nil
+}
+
+func (v percentage_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *percentage_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a percentage: %v",
+			source,
+		)
+	}
+}
+
 // Private Methods
 
 // Instance Structure
@@ -136,8 +325,10 @@ type percentage_ float64
 
 type percentageClass_ struct {
 	// Declare the class constants.
-	matcher_   *reg.Regexp
-	undefined_ PercentageLike
+	matcher_     *reg.Regexp
+	productions_ map[int]string
+	undefined_   PercentageLike
+	cborTag_     uint64
 }
 
 // Class Reference
@@ -148,6 +339,12 @@ func percentageClass() *percentageClass_ {
 
 var percentageClassReference_ = &percentageClass_{
 	// Initialize the class constants.
-	matcher_:   reg.MustCompile("^(" + real_ + ")%"),
+	matcher_: reg.MustCompile(
+		"^(" + float_ + "|0|" + infinity_ + "|" + undefined_ + ")%",
+	),
+	productions_: map[int]string{
+		1: "real number",
+	},
 	undefined_: percentage_(mat.NaN()),
+	cborTag_:   0x63646707,
 }
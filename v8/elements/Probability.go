@@ -13,8 +13,12 @@
 package elements
 
 import (
+	cmp "cmp"
 	ran "crypto/rand"
+	drv "database/sql/driver"
+	jsn "encoding/json"
 	fmt "fmt"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	mat "math"
 	big "math/big"
@@ -63,16 +67,32 @@ func (c *probabilityClass_) ProbabilityFromBoolean(
 func (c *probabilityClass_) ProbabilityFromSource(
 	source string,
 ) ProbabilityLike {
-	var matches = c.matcher_.FindStringSubmatch(source)
+	var probability, err = c.ParseProbability(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return probability
+}
+
+func (c *probabilityClass_) ParseProbability(
+	source string,
+) (
+	probability ProbabilityLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
 	if uti.IsUndefined(matches) {
-		var message = fmt.Sprintf(
-			"An illegal string was passed to the probability constructor method: %s",
+		err = parseErrorClass().ParseErrorFromMatcher(
+			"probability",
+			c.matcher_,
+			c.productions_,
 			source,
 		)
-		panic(message)
+		return
 	}
 	var float, _ = stc.ParseFloat(matches[1], 64) // Strip off the leading 'p'.
-	return probability_(float)
+	probability = probability_(float)
+	return
 }
 
 // Constant Methods
@@ -129,6 +149,21 @@ func (c *probabilityClass_) Xor(
 	return probability_(xor)
 }
 
+func (c *probabilityClass_) Compare(
+	first ProbabilityLike,
+	second ProbabilityLike,
+) int {
+	return cmp.Compare(first.AsFloat(), second.AsFloat())
+}
+
+func (c *probabilityClass_) ApproximatelyEqual(
+	first ProbabilityLike,
+	second ProbabilityLike,
+	tolerance ProbabilityLike,
+) bool {
+	return mat.Abs(first.AsFloat()-second.AsFloat()) <= tolerance.AsFloat()
+}
+
 // INSTANCE INTERFACE
 
 // Principal Methods
@@ -177,12 +212,170 @@ func (v probability_) IsMaximum() bool {
 	return v == 1
 }
 
+// Ordered[ProbabilityLike] Methods
+
+func (v probability_) IsBefore(
+	value ProbabilityLike,
+) bool {
+	return probabilityClass().Compare(v, value) < 0
+}
+
 // PROTECTED INTERFACE
 
 func (v probability_) String() string {
 	return v.AsSource()
 }
 
+func (v probability_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *probability_) UnmarshalText(
+	text []byte,
+) error {
+	var probability, err = probabilityClass().ParseProbability(string(text))
+	if err != nil {
+		return err
+	}
+	*v = probability.(probability_)
+	return nil
+}
+
+func (v probability_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *probability_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v probability_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	var float = v.AsIntrinsic()
+	if !mat.IsNaN(float) && !mat.IsInf(float, 0) {
+		return jsn.Marshal(float)
+	}
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *probability_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	if !jsonClass().isString(bytes) {
+		var float float64
+		var err = jsn.Unmarshal(bytes, &float)
+		if err != nil {
+			return err
+		}
+		if float < 0.0 || float > 1.0 {
+			return fmt.Errorf("A probability must be in the range [0..1]: %v", float)
+		}
+		*v = probability_(float)
+		return nil
+	}
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v probability_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = formatClass().Header(ProbabilityType)
+	bytes = formatClass().appendFloat(bytes, v.AsIntrinsic())
+	return
+}
+
+func (v *probability_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = formatClass().Payload(ProbabilityType, bytes)
+	if err != nil {
+		return err
+	}
+	var floats []float64
+	floats, err = formatClass().floatsFromPayload(payload, 1)
+	if err != nil {
+		return err
+	}
+	var float = floats[0]
+	if float < 0.0 || float > 1.0 {
+		return fmt.Errorf("A probability must be in the range [0..1]: %v", float)
+	}
+	*v = probability_(float)
+	return nil
+}
+
+func (v probability_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	return cborClass().Encode(probabilityClass().cborTag_, v.AsIntrinsic())
+}
+
+func (v *probability_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = cborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != probabilityClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not a probability: %x", bytes)
+	}
+	var float, failure = cborClass().floatFromValue(value)
+	if failure != nil {
+		return failure
+	}
+	if float < 0.0 || float > 1.0 {
+		return fmt.Errorf("A probability must be in the range [0..1]: %v", float)
+	}
+	*v = probability_(float)
+	return nil
+}
+
+func (v probability_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *probability_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a probability: %v",
+			source,
+		)
+	}
+}
+
 // Private Methods
 
 func (c *probabilityClass_) randomInteger(max int) int {
@@ -201,8 +394,10 @@ type probability_ float64
 
 type probabilityClass_ struct {
 	// Declare the class constants.
-	matcher_   *reg.Regexp
-	undefined_ ProbabilityLike
+	matcher_     *reg.Regexp
+	productions_ map[int]string
+	undefined_   ProbabilityLike
+	cborTag_     uint64
 }
 
 // Class Reference
@@ -213,6 +408,10 @@ func probabilityClass() *probabilityClass_ {
 
 var probabilityClassReference_ = &probabilityClass_{
 	// Initialize the class constants.
-	matcher_:   reg.MustCompile("^p(0(?:" + fraction_ + ")?|1)"),
+	matcher_: reg.MustCompile("^p(0(?:" + fraction_ + ")?|1)"),
+	productions_: map[int]string{
+		1: "probability 0-1",
+	},
 	undefined_: probability_(mat.NaN()),
+	cborTag_:   0x63646708,
 }
@@ -0,0 +1,527 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package elements
+
+import (
+	drv "database/sql/driver"
+	jsn "encoding/json"
+	fmt "fmt"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	mat "math"
+	big "math/big"
+	reg "regexp"
+	stc "strconv"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func RationalClass() RationalClassLike {
+	return rationalClass()
+}
+
+// Constructor Methods
+
+func (c *rationalClass_) Rational(
+	numerator int,
+	denominator int,
+) RationalLike {
+	if denominator == 0 {
+		var message = fmt.Sprintf(
+			"A rational cannot have a zero denominator: %v/%v",
+			numerator,
+			denominator,
+		)
+		panic(message)
+	}
+	var rational = big.NewRat(int64(numerator), int64(denominator))
+	return c.rationalFromRat(rational)
+}
+
+func (c *rationalClass_) RationalFromBigRat(
+	rational *big.Rat,
+) RationalLike {
+	if rational == nil {
+		panic("A rational cannot be created from a nil big.Rat.")
+	}
+	return c.rationalFromRat(rational)
+}
+
+func (c *rationalClass_) RationalFromNumber(
+	number NumberLike,
+) RationalLike {
+	if number.GetImaginary() != 0 || !number.IsDefined() || number.IsInfinite() {
+		var message = fmt.Sprintf(
+			"A rational can only be created from a finite real number: %v",
+			number,
+		)
+		panic(message)
+	}
+	return c.rationalFromFloat(number.AsFloat())
+}
+
+func (c *rationalClass_) RationalFromPercentage(
+	percentage PercentageLike,
+) RationalLike {
+	if !percentage.IsDefined() || percentage.IsInfinite() {
+		var message = fmt.Sprintf(
+			"A rational can only be created from a finite percentage: %v",
+			percentage,
+		)
+		panic(message)
+	}
+	return c.rationalFromFloat(percentage.AsIntrinsic())
+}
+
+func (c *rationalClass_) RationalFromProbability(
+	probability ProbabilityLike,
+) RationalLike {
+	if !probability.IsDefined() {
+		panic("A rational cannot be created from an undefined probability.")
+	}
+	return c.rationalFromFloat(probability.AsFloat())
+}
+
+func (c *rationalClass_) RationalFromSource(
+	source string,
+) RationalLike {
+	var rational, err = c.ParseRational(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return rational
+}
+
+func (c *rationalClass_) ParseRational(
+	source string,
+) (
+	rational RationalLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
+	if uti.IsUndefined(matches) {
+		err = parseErrorClass().ParseErrorFromMatcher(
+			"rational",
+			c.matcher_,
+			c.productions_,
+			source,
+		)
+		return
+	}
+	var value, _ = new(big.Rat).SetString(matches[1] + "/" + matches[2])
+	rational = c.rationalFromRat(value) // Reduced to lowest terms.
+	return
+}
+
+// Constant Methods
+
+func (c *rationalClass_) Zero() RationalLike {
+	return c.zero_
+}
+
+func (c *rationalClass_) One() RationalLike {
+	return c.one_
+}
+
+// Function Methods
+
+func (c *rationalClass_) Inverse(
+	rational RationalLike,
+) RationalLike {
+	var value = rational.AsBigRat()
+	return c.rationalFromRat(value.Neg(value))
+}
+
+func (c *rationalClass_) Reciprocal(
+	rational RationalLike,
+) RationalLike {
+	c.validateDivisor(rational)
+	var value = rational.AsBigRat()
+	return c.rationalFromRat(value.Inv(value))
+}
+
+func (c *rationalClass_) Sum(
+	first RationalLike,
+	second RationalLike,
+) RationalLike {
+	var value = new(big.Rat).Add(first.AsBigRat(), second.AsBigRat())
+	return c.rationalFromRat(value)
+}
+
+func (c *rationalClass_) Difference(
+	first RationalLike,
+	second RationalLike,
+) RationalLike {
+	var value = new(big.Rat).Sub(first.AsBigRat(), second.AsBigRat())
+	return c.rationalFromRat(value)
+}
+
+func (c *rationalClass_) Product(
+	first RationalLike,
+	second RationalLike,
+) RationalLike {
+	var value = new(big.Rat).Mul(first.AsBigRat(), second.AsBigRat())
+	return c.rationalFromRat(value)
+}
+
+func (c *rationalClass_) Quotient(
+	first RationalLike,
+	second RationalLike,
+) RationalLike {
+	c.validateDivisor(second)
+	var value = new(big.Rat).Quo(first.AsBigRat(), second.AsBigRat())
+	return c.rationalFromRat(value)
+}
+
+func (c *rationalClass_) Power(
+	base RationalLike,
+	exponent int,
+) RationalLike {
+	// The exponent is negated as a big integer since negating math.MinInt
+	// would overflow.
+	var power = big.NewInt(int64(exponent))
+	if exponent < 0 {
+		base = c.Reciprocal(base)
+		power.Neg(power)
+	}
+	var numerator = new(big.Int).Exp(base.GetNumerator(), power, nil)
+	var denominator = new(big.Int).Exp(base.GetDenominator(), power, nil)
+	return c.rationalFromRat(new(big.Rat).SetFrac(numerator, denominator))
+}
+
+// NOTE:
+// This function uses the continued fraction expansion of the specified float to
+// find the rational that is closest to it and whose denominator does not exceed
+// the specified limit.  The convergents of the expansion alternate around the
+// float, and the best approximation is either the last convergent within the
+// limit or the largest semiconvergent that lies between it and the previous
+// convergent.
+//   - https://en.wikipedia.org/wiki/Continued_fraction#Best_rational_approximations
+func (c *rationalClass_) Approximation(
+	float float64,
+	limit uint,
+) RationalLike {
+	if limit == 0 {
+		panic("The denominator limit for an approximation must be positive.")
+	}
+	var target = c.exactRat(float)
+	var maximum = new(big.Int).SetUint64(uint64(limit))
+	if target.Denom().Cmp(maximum) <= 0 {
+		return c.rationalFromRat(target)
+	}
+
+	// Accumulate the convergents p/q until the next one exceeds the limit.
+	var p0, q0 = big.NewInt(0), big.NewInt(1)
+	var p1, q1 = big.NewInt(1), big.NewInt(0)
+	var n = new(big.Int).Set(target.Num())
+	var d = new(big.Int).Set(target.Denom())
+	for {
+		var a = new(big.Int).Div(n, d) // The floor since d is positive.
+		var q2 = new(big.Int).Add(q0, new(big.Int).Mul(a, q1))
+		if q2.Cmp(maximum) > 0 {
+			break
+		}
+		var p2 = new(big.Int).Add(p0, new(big.Int).Mul(a, p1))
+		p0, q0, p1, q1 = p1, q1, p2, q2
+		n, d = d, new(big.Int).Sub(n, new(big.Int).Mul(a, d))
+	}
+
+	// Compare the last convergent with the largest semiconvergent.
+	var k = new(big.Int).Div(new(big.Int).Sub(maximum, q0), q1)
+	var semiconvergent = new(big.Rat).SetFrac(
+		new(big.Int).Add(p0, new(big.Int).Mul(k, p1)),
+		new(big.Int).Add(q0, new(big.Int).Mul(k, q1)),
+	)
+	var convergent = new(big.Rat).SetFrac(p1, q1)
+	var semiconvergentError = new(big.Rat).Sub(semiconvergent, target)
+	var convergentError = new(big.Rat).Sub(convergent, target)
+	if semiconvergentError.Abs(semiconvergentError).Cmp(
+		convergentError.Abs(convergentError),
+	) < 0 {
+		return c.rationalFromRat(semiconvergent)
+	}
+	return c.rationalFromRat(convergent)
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v rational_) GetClass() RationalClassLike {
+	return rationalClass()
+}
+
+func (v rational_) GetNumerator() *big.Int {
+	return v.asRat().Num()
+}
+
+func (v rational_) GetDenominator() *big.Int {
+	return v.asRat().Denom()
+}
+
+func (v rational_) AsBigRat() *big.Rat {
+	return v.asRat()
+}
+
+func (v rational_) AsNumber() NumberLike {
+	return numberClass().NumberFromBigRat(v.asRat())
+}
+
+func (v rational_) AsPercentage() PercentageLike {
+	var percent = v.asRat()
+	percent.Mul(percent, big.NewRat(100, 1))
+	var float, _ = percent.Float64()
+	return percentageClass().Percentage(float)
+}
+
+func (v rational_) AsProbability() ProbabilityLike {
+	return probabilityClass().Probability(v.AsFloat())
+}
+
+// Attribute Methods
+
+// Continuous Methods
+
+func (v rational_) AsSource() string {
+	return string(v)
+}
+
+func (v rational_) AsFloat() float64 {
+	var float, _ = v.asRat().Float64() // Rounded to the nearest float64.
+	return float
+}
+
+func (v rational_) HasMagnitude() bool {
+	return !v.IsZero()
+}
+
+func (v rational_) IsInfinite() bool {
+	return false
+}
+
+func (v rational_) IsDefined() bool {
+	return true
+}
+
+func (v rational_) IsMinimum() bool {
+	return false
+}
+
+func (v rational_) IsZero() bool {
+	return v == rationalClass().zero_
+}
+
+func (v rational_) IsMaximum() bool {
+	return false
+}
+
+// Polarized Methods
+
+func (v rational_) IsNegative() bool {
+	return sts.HasPrefix(string(v), "-")
+}
+
+// PROTECTED INTERFACE
+
+func (v rational_) String() string {
+	return v.AsSource()
+}
+
+func (v rational_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *rational_) UnmarshalText(
+	text []byte,
+) error {
+	var rational, err = rationalClass().ParseRational(string(text))
+	if err != nil {
+		return err
+	}
+	*v = rational.(rational_)
+	return nil
+}
+
+func (v rational_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	// There is no native JSON representation for an exact rational.
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *rational_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v rational_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = formatClass().Header(RationalType)
+	bytes = append(bytes, v.AsSource()...)
+	return
+}
+
+func (v *rational_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = formatClass().Payload(RationalType, bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(payload)
+}
+
+func (v rational_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	return cborClass().Encode(rationalClass().cborTag_, v.AsSource())
+}
+
+func (v *rational_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = cborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != rationalClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not a rational: %x", bytes)
+	}
+	var source, ok = value.(string)
+	if !ok {
+		return fmt.Errorf("The CBOR data item is not a rational: %x", bytes)
+	}
+	return v.UnmarshalText([]byte(source))
+}
+
+func (v rational_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *rational_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a rational: %v",
+			source,
+		)
+	}
+}
+
+// Private Methods
+
+// This private method returns the exact value of the specified float as a
+// big.Rat, panicking if the float is not finite.
+func (c *rationalClass_) exactRat(
+	float float64,
+) *big.Rat {
+	if mat.IsNaN(float) || mat.IsInf(float, 0) {
+		var message = fmt.Sprintf(
+			"A rational cannot be created from a non-finite float: %v",
+			float,
+		)
+		panic(message)
+	}
+	return new(big.Rat).SetFloat64(float)
+}
+
+// This private method returns the rational for the shortest decimal that
+// rounds to the specified float (e.g. 0.1 becomes "1/10" rather than the exact
+// binary value of the float).
+func (c *rationalClass_) rationalFromFloat(
+	float float64,
+) rational_ {
+	c.exactRat(float) // Validate the float.
+	var value, _ = new(big.Rat).SetString(stc.FormatFloat(float, 'g', -1, 64))
+	return c.rationalFromRat(value)
+}
+
+func (c *rationalClass_) rationalFromRat(
+	rational *big.Rat,
+) rational_ {
+	return rational_(rational.String()) // Always in lowest terms.
+}
+
+func (c *rationalClass_) validateDivisor(
+	divisor RationalLike,
+) {
+	if divisor.IsZero() {
+		panic("A rational cannot be divided by zero.")
+	}
+}
+
+func (v rational_) asRat() *big.Rat {
+	var rational, _ = new(big.Rat).SetString(string(v))
+	return rational
+}
+
+// Instance Structure
+
+type rational_ string // The canonical source in lowest terms.
+
+// Class Structure
+
+type rationalClass_ struct {
+	// Declare the class constants.
+	matcher_     *reg.Regexp
+	productions_ map[int]string
+	zero_        rational_
+	one_         rational_
+	cborTag_     uint64
+}
+
+// Class Reference
+
+func rationalClass() *rationalClass_ {
+	return rationalClassReference_
+}
+
+var rationalClassReference_ = &rationalClass_{
+	// Initialize the class constants.
+	matcher_: reg.MustCompile(
+		"^((?:" + sign_ + ")?(?:0|" + ordinal_ + "))/(" + ordinal_ + ")",
+	),
+	productions_: map[int]string{
+		1: "numerator",
+		2: "denominator",
+	},
+	zero_:    rational_("0/1"),
+	one_:     rational_("1/1"),
+	cborTag_: 0x6364670d,
+}
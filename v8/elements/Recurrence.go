@@ -0,0 +1,804 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package elements
+
+import (
+	drv "database/sql/driver"
+	jsn "encoding/json"
+	fmt "fmt"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	mat "math"
+	reg "regexp"
+	sli "slices"
+	stc "strconv"
+	sts "strings"
+	tim "time"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func RecurrenceClass() RecurrenceClassLike {
+	return recurrenceClass()
+}
+
+// Constructor Methods
+
+func (c *recurrenceClass_) Recurrence(
+	start MomentLike,
+	duration DurationLike,
+	count uint,
+) RecurrenceLike {
+	if duration.IsZero() || duration.IsNegative() {
+		var message = fmt.Sprintf(
+			"A recurrence must repeat after a positive duration: %v",
+			duration,
+		)
+		panic(message)
+	}
+	if duration.GetMicroseconds() > 0 || duration.GetNanoseconds() > 0 {
+		var message = fmt.Sprintf(
+			"A recurrence must repeat after a whole number of milliseconds: %v",
+			duration,
+		)
+		panic(message)
+	}
+	return recurrence_{
+		start_: start.AsIntrinsic(),
+		step_:  duration.AsIntrinsic(),
+		count_: int(count),
+		until_: mat.MaxInt,
+	}
+}
+
+func (c *recurrenceClass_) RecurrenceFromSource(
+	source string,
+) RecurrenceLike {
+	var recurrence, err = c.ParseRecurrence(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return recurrence
+}
+
+func (c *recurrenceClass_) ParseRecurrence(
+	source string,
+) (
+	recurrence RecurrenceLike,
+	err error,
+) {
+	var rule, failure = c.ruleFromSource(source)
+	if failure != nil {
+		err = failure
+		return
+	}
+	recurrence = rule
+	return
+}
+
+// Constant Methods
+
+// Function Methods
+
+func (c *recurrenceClass_) Occurrences(
+	recurrence RecurrenceLike,
+	window IntervalLike,
+) uti.Ratcheted[MomentLike] {
+	var rule, ok = recurrence.(recurrence_)
+	if !ok {
+		rule, _ = c.ruleFromSource(recurrence.AsSource())
+	}
+	var bounds = window.AsIntrinsic()
+	return rule.occurrences(bounds[0], bounds[1])
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v recurrence_) GetClass() RecurrenceClassLike {
+	return recurrenceClass()
+}
+
+func (v recurrence_) AsSource() string {
+	return recurrenceClass().sourceFromRule(v)
+}
+
+func (v recurrence_) GetStart() MomentLike {
+	return moment_{milliseconds_: v.start_}
+}
+
+func (v recurrence_) IsBounded() bool {
+	return v.count_ > 0 || v.until_ < mat.MaxInt
+}
+
+func (v recurrence_) GetIterator() uti.Ratcheted[MomentLike] {
+	if !v.IsBounded() {
+		var message = fmt.Sprintf(
+			"An unbounded recurrence can only be iterated over an interval: %v",
+			v,
+		)
+		panic(message)
+	}
+	return v.occurrences(mat.MinInt, mat.MaxInt)
+}
+
+// Attribute Methods
+
+// PROTECTED INTERFACE
+
+func (v recurrence_) String() string {
+	return v.AsSource()
+}
+
+func (v recurrence_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *recurrence_) UnmarshalText(
+	text []byte,
+) error {
+	var recurrence, err = recurrenceClass().ParseRecurrence(string(text))
+	if err != nil {
+		return err
+	}
+	*v = recurrence.(recurrence_)
+	return nil
+}
+
+func (v recurrence_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	// A recurrence has no native JSON type so both encodings use its source.
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *recurrence_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v recurrence_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = formatClass().Header(RecurrenceType)
+	bytes = append(bytes, v.AsSource()...)
+	return
+}
+
+func (v *recurrence_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = formatClass().Payload(RecurrenceType, bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(payload)
+}
+
+func (v recurrence_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	return cborClass().Encode(recurrenceClass().cborTag_, v.AsSource())
+}
+
+func (v *recurrence_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = cborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != recurrenceClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not a recurrence: %x", bytes)
+	}
+	var source, ok = value.(string)
+	if !ok {
+		return fmt.Errorf("The CBOR data item is not a recurrence: %x", bytes)
+	}
+	return v.UnmarshalText([]byte(source))
+}
+
+func (v recurrence_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *recurrence_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a recurrence: %v",
+			source,
+		)
+	}
+}
+
+// Private Methods
+
+// This private method parses the specified source string into the rule that it
+// represents.
+func (c *recurrenceClass_) ruleFromSource(
+	source string,
+) (
+	rule recurrence_,
+	err error,
+) {
+	rule.until_ = mat.MaxInt
+	var matches = gra.MatchSource(c.matcher_, source)
+	if uti.IsUndefined(matches) {
+		err = parseErrorClass().ParseErrorFromMatcher(
+			"recurrence",
+			c.matcher_,
+			c.productions_,
+			source,
+		)
+		return
+	}
+	var indices = c.matcher_.FindStringSubmatchIndex(source)
+	var start, _ = momentClass().ParseMoment(matches[2])
+	if start == nil {
+		err = c.ruleError(source, indices[4], "a date-time that exists on the calendar")
+		return
+	}
+	rule.start_ = start.AsIntrinsic()
+	if len(matches[1]) > 0 {
+		rule.count_, _ = stc.Atoi(matches[1])
+	}
+	var group = c.momentGroups_ + 3
+	if indices[2*group] >= 0 {
+		// This is an ISO 8601 repeating interval.
+		var duration, _ = durationClass().ParseDuration(matches[group])
+		rule.step_ = duration.AsIntrinsic()
+		switch {
+		case duration.IsZero() || duration.IsNegative():
+			err = c.ruleError(source, indices[2*group], "a positive duration")
+		case duration.GetMicroseconds() > 0 || duration.GetNanoseconds() > 0:
+			err = c.ruleError(source, indices[2*group], "a whole number of milliseconds")
+		}
+		return
+	}
+
+	// This is a recurrence rule.
+	if len(matches[1]) > 0 {
+		err = c.ruleError(source, indices[2], `"/" (the rule specifies any count)`)
+		return
+	}
+	group = c.momentGroups_ + c.durationGroups_ + 4
+	rule.frequency_ = matches[group]
+	rule.interval_ = 1
+	var offset = indices[2*group+3]
+	var names []string
+	for _, part := range sts.Split(matches[group+1], ";")[1:] {
+		offset++ // Skip the ";".
+		var name, value, _ = sts.Cut(part, "=")
+		if sli.Contains(names, name) || (name == "COUNT" && sli.Contains(names, "UNTIL")) ||
+			(name == "UNTIL" && sli.Contains(names, "COUNT")) {
+			err = c.ruleError(source, offset, "a rule part that is not repeated or conflicting")
+			return
+		}
+		names = append(names, name)
+		switch name {
+		case "INTERVAL":
+			rule.interval_, _ = stc.Atoi(value)
+		case "COUNT":
+			rule.count_, _ = stc.Atoi(value)
+		case "UNTIL":
+			var until, ok = c.momentFromUntil(value)
+			if !ok {
+				err = c.ruleError(source, offset, "a date-time that exists on the calendar")
+				return
+			}
+			rule.until_ = until
+		case "BYDAY":
+			for _, day := range sts.Split(value, ",") {
+				rule.weekdays_ |= 1 << sli.Index(c.weekdays_, day)
+			}
+		case "BYMONTHDAY":
+			for _, day := range sts.Split(value, ",") {
+				var monthday, _ = stc.Atoi(day)
+				if monthday < -31 || monthday > 31 {
+					err = c.ruleError(source, offset, "a day of the month in the range [-31..31]")
+					return
+				}
+				rule.monthdays_ |= 1 << (monthday + 31)
+			}
+		}
+		offset += len(part)
+	}
+	if !rule.hasOccurrences() {
+		err = c.ruleError(source, indices[2*group], "a rule that has occurrences")
+	}
+	return
+}
+
+// This private method returns a parse error for the specified recurrence source
+// string.
+func (c *recurrenceClass_) ruleError(
+	source string,
+	offset int,
+	expected string,
+) error {
+	return parseErrorClass().ParseError(
+		"recurrence",
+		source,
+		uint(offset),
+		expected,
+	)
+}
+
+// This private method parses the RFC 5545 date (e.g. "20240131") or UTC
+// date-time (e.g. "20240131T235959Z") of an UNTIL rule part.
+func (c *recurrenceClass_) momentFromUntil(
+	until string,
+) (
+	milliseconds int,
+	ok bool,
+) {
+	var format = "20060102"
+	if len(until) > len(format) {
+		format = "20060102T150405"
+		until = sts.TrimSuffix(until, "Z")
+	}
+	var time, err = tim.Parse(format, until) // Parsed in UTC.
+	if err != nil {
+		return
+	}
+	milliseconds = int(time.UnixMilli())
+	ok = true
+	return
+}
+
+// This private method returns the canonical source string for the specified
+// rule.
+func (c *recurrenceClass_) sourceFromRule(
+	rule recurrence_,
+) string {
+	var builder sts.Builder
+	builder.WriteString("R")
+	if rule.step_ > 0 && rule.count_ > 0 {
+		builder.WriteString(stc.Itoa(rule.count_))
+	}
+	builder.WriteString("/")
+	builder.WriteString(moment_{milliseconds_: rule.start_}.AsSource())
+	builder.WriteString("/")
+	if rule.step_ > 0 {
+		builder.WriteString(duration_{milliseconds_: rule.step_}.AsSource())
+		return builder.String()
+	}
+	builder.WriteString("FREQ=")
+	builder.WriteString(rule.frequency_)
+	if rule.interval_ > 1 {
+		builder.WriteString(";INTERVAL=")
+		builder.WriteString(stc.Itoa(rule.interval_))
+	}
+	if rule.count_ > 0 {
+		builder.WriteString(";COUNT=")
+		builder.WriteString(stc.Itoa(rule.count_))
+	}
+	if rule.until_ < mat.MaxInt {
+		builder.WriteString(";UNTIL=")
+		var until = tim.UnixMilli(int64(rule.until_)).UTC()
+		builder.WriteString(until.Format("20060102T150405Z"))
+	}
+	if rule.weekdays_ != 0 {
+		builder.WriteString(";BYDAY=")
+		var separator = ""
+		for index := 1; index <= 7; index++ {
+			// Weeks start on Monday.
+			var weekday = index % 7
+			if rule.weekdays_&(1<<weekday) != 0 {
+				builder.WriteString(separator)
+				builder.WriteString(c.weekdays_[weekday])
+				separator = ","
+			}
+		}
+	}
+	if rule.monthdays_ != 0 {
+		builder.WriteString(";BYMONTHDAY=")
+		var separator = ""
+		for monthday := -31; monthday <= 31; monthday++ {
+			if rule.monthdays_&(1<<(monthday+31)) != 0 {
+				builder.WriteString(separator)
+				builder.WriteString(stc.Itoa(monthday))
+				separator = ","
+			}
+		}
+	}
+	return builder.String()
+}
+
+// This private method returns an iterator over the occurrences of the rule that
+// fall within the specified half-open window of milliseconds.
+func (v recurrence_) occurrences(
+	first int,
+	last int,
+) *occurrences_ {
+	var cursor = cursor_{
+		rule_:  v,
+		first_: first,
+		last_:  last,
+	}
+	if v.step_ > 0 && first > v.start_ {
+		// Skip the occurrences that precede the window.
+		cursor.count_ = (first - v.start_ + v.step_ - 1) / v.step_
+	}
+	if v.step_ == 0 {
+		cursor.origin_ = tim.UnixMilli(int64(v.start_)).UTC()
+		var year, month, day = cursor.origin_.Date()
+		cursor.midnight_ = tim.Date(year, month, day, 0, 0, 0, 0, tim.UTC)
+		cursor.clock_ = cursor.origin_.Sub(cursor.midnight_)
+	}
+	return &occurrences_{cursor_: cursor, size_: -1}
+}
+
+// This private method determines whether or not the rule has any occurrences.
+// The Gregorian calendar repeats itself every 400 years, so if none of the
+// periods in a complete cycle contains a matching day the rule never matches.
+func (v recurrence_) hasOccurrences() bool {
+	if v.step_ > 0 {
+		return true
+	}
+	var cycle int
+	switch v.frequency_ {
+	case "DAILY":
+		cycle = recurrenceClass().daysPerCycle_
+	case "WEEKLY":
+		cycle = recurrenceClass().daysPerCycle_ / 7
+	case "MONTHLY":
+		cycle = 400 * 12
+	case "YEARLY":
+		cycle = 400
+	}
+	var divisor, remainder = cycle, v.interval_
+	for remainder != 0 {
+		divisor, remainder = remainder, divisor%remainder
+	}
+	var origin = tim.UnixMilli(int64(v.start_)).UTC()
+	var year, month, day = origin.Date()
+	var midnight = tim.Date(year, month, day, 0, 0, 0, 0, tim.UTC)
+	for period := 0; period < cycle/divisor; period++ {
+		for _, day := range v.periodDays(midnight, period*v.interval_) {
+			if v.matches(origin, day) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// This private method returns the days in the period that is the specified
+// number of periods after the period containing the specified midnight.
+func (v recurrence_) periodDays(
+	midnight tim.Time,
+	periods int,
+) []tim.Time {
+	var first, last tim.Time
+	switch v.frequency_ {
+	case "DAILY":
+		first = midnight.AddDate(0, 0, periods)
+		last = first
+	case "WEEKLY":
+		// Weeks start on Monday.
+		var monday = midnight.AddDate(0, 0, -(int(midnight.Weekday())+6)%7)
+		first = monday.AddDate(0, 0, 7*periods)
+		last = first.AddDate(0, 0, 6)
+	case "MONTHLY":
+		var year, month, _ = midnight.Date()
+		first = tim.Date(year, month+tim.Month(periods), 1, 0, 0, 0, 0, tim.UTC)
+		last = first.AddDate(0, 1, -1)
+	case "YEARLY":
+		first = tim.Date(midnight.Year()+periods, 1, 1, 0, 0, 0, 0, tim.UTC)
+		last = first.AddDate(1, 0, -1)
+	}
+	var days []tim.Time
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+	}
+	return days
+}
+
+// This private method determines whether or not the specified day is an
+// occurrence of the rule.  When the BYDAY and BYMONTHDAY rule parts are missing
+// the day of the week, month or year is taken from the origin of the rule as
+// specified in RFC 5545.
+func (v recurrence_) matches(
+	origin tim.Time,
+	day tim.Time,
+) bool {
+	switch {
+	case v.monthdays_ != 0:
+		var last = day.AddDate(0, 1, -day.Day()).Day()
+		var forward = v.monthdays_&(1<<(day.Day()+31)) != 0
+		var backward = v.monthdays_&(1<<(day.Day()-last-1+31)) != 0
+		if !forward && !backward {
+			return false
+		}
+	case v.weekdays_ == 0 && v.frequency_ == "MONTHLY":
+		return day.Day() == origin.Day()
+	case v.weekdays_ == 0 && v.frequency_ == "YEARLY":
+		return day.Month() == origin.Month() && day.Day() == origin.Day()
+	}
+	switch {
+	case v.weekdays_ != 0:
+		return v.weekdays_&(1<<day.Weekday()) != 0
+	case v.monthdays_ == 0 && v.frequency_ == "WEEKLY":
+		return day.Weekday() == origin.Weekday()
+	}
+	return true
+}
+
+// This private method returns the next occurrence of the rule that falls within
+// the window of the cursor, or false if there are no more such occurrences.
+func (v *cursor_) next() (
+	milliseconds int,
+	ok bool,
+) {
+	var rule = v.rule_
+	for !v.done_ {
+		if rule.count_ > 0 && v.count_ >= rule.count_ {
+			break
+		}
+		if rule.step_ > 0 {
+			// This is an ISO 8601 repeating interval.
+			milliseconds = rule.start_ + v.count_*rule.step_
+		} else {
+			// This is a recurrence rule so each period is expanded into its days.
+			for len(v.pending_) == 0 {
+				var days = rule.periodDays(v.midnight_, v.period_*rule.interval_)
+				var start = int(days[0].UnixMilli())
+				if start >= v.last_ || start > rule.until_ {
+					v.done_ = true
+					return
+				}
+				v.period_++
+				v.pending_ = nil
+				for _, day := range days {
+					var occurrence = int(day.Add(v.clock_).UnixMilli())
+					if occurrence >= rule.start_ && rule.matches(v.origin_, day) {
+						v.pending_ = append(v.pending_, occurrence)
+					}
+				}
+			}
+			milliseconds = v.pending_[0]
+			v.pending_ = v.pending_[1:]
+		}
+		if milliseconds >= v.last_ || milliseconds > rule.until_ {
+			break
+		}
+		v.count_++
+		if milliseconds >= v.first_ {
+			ok = true
+			return
+		}
+	}
+	v.done_ = true
+	return
+}
+
+func (v *occurrences_) ToStart() {
+	v.slot_ = 0
+}
+
+func (v *occurrences_) ToEnd() {
+	v.slot_ = v.GetSize()
+}
+
+func (v *occurrences_) IsEmpty() bool {
+	return v.GetSize() == 0
+}
+
+func (v *occurrences_) GetSize() uint {
+	if v.size_ < 0 {
+		// Count the remaining occurrences without keeping them.
+		var counter = v.cursor_
+		v.size_ = len(v.moments_)
+		for _, ok := counter.next(); ok; _, ok = counter.next() {
+			v.size_++
+		}
+	}
+	return uint(v.size_)
+}
+
+func (v *occurrences_) GetSlot() uint {
+	return v.slot_
+}
+
+func (v *occurrences_) SetSlot(
+	slot uint,
+) {
+	v.slot_ = min(slot, v.GetSize())
+}
+
+func (v *occurrences_) HasPrevious() bool {
+	return v.slot_ > 0
+}
+
+func (v *occurrences_) GetPrevious() MomentLike {
+	if v.slot_ == 0 {
+		return nil
+	}
+	v.slot_--
+	return moment_{milliseconds_: v.occurrence(v.slot_)}
+}
+
+func (v *occurrences_) HasNext() bool {
+	if v.size_ >= 0 {
+		return v.slot_ < uint(v.size_)
+	}
+	return v.generate(v.slot_)
+}
+
+func (v *occurrences_) GetNext() MomentLike {
+	if !v.HasNext() {
+		return nil
+	}
+	v.slot_++
+	return moment_{milliseconds_: v.occurrence(v.slot_ - 1)}
+}
+
+// This private method generates the occurrences up to and including the one in
+// the specified slot and returns whether or not that occurrence exists.
+func (v *occurrences_) generate(
+	slot uint,
+) bool {
+	for uint(len(v.moments_)) <= slot {
+		var milliseconds, ok = v.cursor_.next()
+		if !ok {
+			v.size_ = len(v.moments_)
+			return false
+		}
+		v.moments_ = append(v.moments_, milliseconds)
+	}
+	return true
+}
+
+// This private method returns the occurrence in the specified slot, generating
+// it if necessary.
+func (v *occurrences_) occurrence(
+	slot uint,
+) int {
+	v.generate(slot)
+	return v.moments_[slot]
+}
+
+// NOTE:
+// These private constants are used to define the private regular expression
+// matcher that is used to match legal string patterns for this intrinsic type.
+// Unfortunately there is no way to make them private to this class since they
+// must be TRUE Go constants to be used in this way.  We append an underscore to
+// each name to lessen the chance of a name collision with other private Go
+// class constants in this package.
+const (
+	frequency_ = "DAILY|WEEKLY|MONTHLY|YEARLY"
+	monthday_  = "-?[1-9][0-9]?"
+	rulePart_  = "INTERVAL=" + ordinal_ + "|COUNT=" + ordinal_ +
+		"|UNTIL=[0-9]{8}(?:T[0-9]{6}Z?)?|BYDAY=(?:" + weekday_ + ")(?:,(?:" +
+		weekday_ + "))*|BYMONTHDAY=(?:" + monthday_ + ")(?:,(?:" + monthday_ +
+		"))*"
+	weekday_ = "MO|TU|WE|TH|FR|SA|SU"
+)
+
+// Instance Structure
+
+// A recurrence holds the rule that its source string represents.  An ISO 8601
+// repeating interval has a positive step while a recurrence rule has a
+// frequency instead.  The weekdays and days of the month are sets of bits.
+type recurrence_ struct {
+	start_     int
+	step_      int
+	frequency_ string
+	interval_  int
+	count_     int
+	until_     int // The math.MaxInt value means there is no UNTIL rule part.
+	weekdays_  uint8
+	monthdays_ uint64
+}
+
+// This private type is a lazy iterator over the occurrences of a recurrence
+// that fall within a window.  The occurrences are generated as they are needed
+// and kept so that the iterator can move in both directions.
+type occurrences_ struct {
+	cursor_  cursor_
+	moments_ []int
+	size_    int // A negative size means the size is not yet known.
+	slot_    uint
+}
+
+// This private type holds the state of the generation of the occurrences of a
+// recurrence that fall within the half-open window from first to last.
+type cursor_ struct {
+	rule_     recurrence_
+	first_    int
+	last_     int
+	origin_   tim.Time
+	midnight_ tim.Time
+	clock_    tim.Duration
+	period_   int
+	pending_  []int
+	count_    int
+	done_     bool
+}
+
+// Class Structure
+
+type recurrenceClass_ struct {
+	// Declare the class constants.
+	matcher_        *reg.Regexp
+	productions_    map[int]string
+	momentGroups_   int
+	durationGroups_ int
+	weekdays_       []string
+	daysPerCycle_   int
+	cborTag_        uint64
+}
+
+// Class Reference
+
+func recurrenceClass() *recurrenceClass_ {
+	return recurrenceClassReference_
+}
+
+// The recurrence matcher is composed from the moment and duration matchers
+// (minus their leading "^" anchors) so its capture groups are numbered as
+// follows:
+//   - 1: the number of occurrences
+//   - 2: the start moment
+//   - momentGroups_ + 3: the duration
+//   - momentGroups_ + durationGroups_ + 4: the frequency
+//   - momentGroups_ + durationGroups_ + 5: the remaining rule parts
+var recurrenceClassReference_ = &recurrenceClass_{
+	// Initialize the class constants.
+	matcher_: reg.MustCompile(
+		"^R(" + ordinal_ + ")?/(" + momentClass().matcher_.String()[1:] +
+			")/(?:(" + durationClass().matcher_.String()[1:] + ")|FREQ=(" +
+			frequency_ + ")((?:;(?:" + rulePart_ + "))*))",
+	),
+	productions_: map[int]string{
+		1:                                      "number of occurrences",
+		2:                                      "start moment",
+		momentClass().matcher_.NumSubexp() + 3: "duration",
+		momentClass().matcher_.NumSubexp() +
+			durationClass().matcher_.NumSubexp() + 4: "frequency",
+		momentClass().matcher_.NumSubexp() +
+			durationClass().matcher_.NumSubexp() + 5: "rule part",
+	},
+	momentGroups_:   momentClass().matcher_.NumSubexp(),
+	durationGroups_: durationClass().matcher_.NumSubexp(),
+	weekdays_:       []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"},
+	daysPerCycle_:   146097, // The days in 400 Gregorian years.
+	cborTag_:        0x6364670b,
+}
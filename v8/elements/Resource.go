@@ -13,7 +13,10 @@
 package elements
 
 import (
+	drv "database/sql/driver"
+	jsn "encoding/json"
 	fmt "fmt"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	uri "net/url"
 	reg "regexp"
@@ -38,21 +41,42 @@ func (c *resourceClass_) Resource(
 func (c *resourceClass_) ResourceFromSource(
 	source string,
 ) ResourceLike {
-	var matches = c.matcher_.FindStringSubmatch(source)
+	var resource, err = c.ParseResource(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return resource
+}
+
+func (c *resourceClass_) ParseResource(
+	source string,
+) (
+	resource ResourceLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
 	if uti.IsUndefined(matches) {
-		var message = fmt.Sprintf(
-			"An illegal string was passed to the resource constructor method: %s",
+		err = parseErrorClass().ParseErrorFromMatcher(
+			"resource",
+			c.matcher_,
+			c.productions_,
 			source,
 		)
-		panic(message)
+		return
 	}
-	return resource_(matches[1]) // Strip off the angle brackets.
+	resource = resource_(matches[1]) // Strip off the angle brackets.
+	return
 }
 
 func (c *resourceClass_) ResourceFromUri(
 	url *uri.URL,
 ) ResourceLike {
-	return resource_(url.String())
+	if url == nil {
+		panic("A resource cannot be created from a nil URI.")
+	}
+	// A relative URI or one that cannot be written between angle brackets is
+	// not a valid resource.
+	return c.ResourceFromSource("<" + url.String() + ">")
 }
 
 // Constant Methods
@@ -124,6 +148,127 @@ func (v resource_) String() string {
 	return v.AsSource()
 }
 
+func (v resource_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *resource_) UnmarshalText(
+	text []byte,
+) error {
+	var resource, err = resourceClass().ParseResource(string(text))
+	if err != nil {
+		return err
+	}
+	*v = resource.(resource_)
+	return nil
+}
+
+func (v resource_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *resource_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source, err = jsonClass().sourceFromJson(bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(source)
+}
+
+func (v resource_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsIntrinsic())
+}
+
+func (v *resource_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	var uri string
+	var err = jsn.Unmarshal(bytes, &uri)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte("<" + uri + ">"))
+}
+
+func (v resource_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = formatClass().Header(ResourceType)
+	bytes = append(bytes, v.AsSource()...)
+	return
+}
+
+func (v *resource_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = formatClass().Payload(ResourceType, bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(payload)
+}
+
+func (v resource_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	return cborClass().Encode(resourceClass().cborTag_, v.AsIntrinsic())
+}
+
+func (v *resource_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = cborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != resourceClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not a resource: %x", bytes)
+	}
+	var uri, ok = value.(string)
+	if !ok {
+		return fmt.Errorf("The CBOR data item is not a resource: %x", bytes)
+	}
+	return v.UnmarshalText([]byte("<" + uri + ">"))
+}
+
+func (v resource_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *resource_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a resource: %v",
+			source,
+		)
+	}
+}
+
 // Private Methods
 
 // NOTE:
@@ -151,8 +296,10 @@ type resource_ string
 
 type resourceClass_ struct {
 	// Declare the class constants.
-	matcher_   *reg.Regexp
-	undefined_ ResourceLike
+	matcher_     *reg.Regexp
+	productions_ map[int]string
+	undefined_   ResourceLike
+	cborTag_     uint64
 }
 
 // Class Reference
@@ -167,5 +314,13 @@ var resourceClassReference_ = &resourceClass_{
 		"^<((" + scheme_ + "):(?://(" + authority_ + "))?(" + path_ +
 			")(?:\\?(" + query_ + "))?(?:#(" + fragment_ + "))?)>",
 	),
+	productions_: map[int]string{
+		2: "scheme",
+		3: "authority",
+		4: "path",
+		5: "query",
+		6: "fragment",
+	},
 	undefined_: resource_("<>"),
+	cborTag_:   32,
 }
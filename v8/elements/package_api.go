@@ -27,15 +27,112 @@ Additional concrete implementations of the classes declared by this package can
 be developed and used seamlessly since the interface declarations only depend on
 other interfaces and intrinsic types—and the class implementations only depend
 on interfaces, not on each other.
+
+Each primitive implements the sql.Scanner and driver.Valuer interfaces so that
+it may be stored in a database column.  A moment is stored as a timestamp, a
+duration as a bigint number of milliseconds (or as its source text when it has
+sub-millisecond precision), a boolean as a bool and all other primitives as
+their canonical source text.
 */
 package elements
 
 import (
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	big "math/big"
 	uri "net/url"
+	reg "regexp"
+	tim "time"
 )
 
 // TYPE DECLARATIONS
 
+/*
+Encoding is a constrained type representing the possible ways that a primitive
+can be encoded as JSON.  A source encoding uses a JSON string containing the
+canonical source form of the primitive (e.g. "<2024-05-01T12>").  A native
+encoding uses the JSON type that most closely matches the intrinsic value of
+the primitive (e.g. the number of milliseconds since the epoch for a moment).
+*/
+type Encoding uint8
+
+const (
+	SourceEncoding Encoding = iota
+	NativeEncoding
+)
+
+/*
+Period is a constrained type representing the calendar periods that a moment
+may be truncated or rounded to.  A week starts on Monday as in ISO 8601.
+*/
+type Period uint8
+
+const (
+	DayPeriod Period = iota
+	WeekPeriod
+	MonthPeriod
+	QuarterPeriod
+	YearPeriod
+)
+
+/*
+Precision is a constrained type representing the possible policies that a
+number or angle class may use to hide the round-off errors of double precision
+floating point values.  The locked precision locks a value onto a nearby exact
+value like 0, 1, -1 or π/2 when the two values are indistinguishable at single
+precision, the exact precision leaves each double precision value unchanged,
+and the significant precision rounds each value to the significant digits of
+the class.  Each class returned by WithPrecision() keeps its own policy.
+*/
+type Precision uint8
+
+const (
+	LockedPrecision Precision = iota
+	ExactPrecision
+	SignificantPrecision
+)
+
+/*
+Rounding is a constrained type representing the possible ways that a decimal
+may be rounded to a smaller scale.  The half-even rounding mode (also known as
+banker's rounding) rounds a tie to the nearest even digit, the half-up rounding
+mode rounds a tie away from zero, the down rounding mode truncates toward zero,
+and the ceiling and floor rounding modes round toward positive and negative
+infinity respectively.
+*/
+type Rounding uint8
+
+const (
+	HalfEvenRounding Rounding = iota
+	HalfUpRounding
+	DownRounding
+	CeilingRounding
+	FloorRounding
+)
+
+/*
+Type is a constrained type representing the type byte that identifies the class
+of a primitive within its binary encoding.  The type bytes for the primitive
+classes declared in other packages are declared in those packages.
+*/
+type Type uint8
+
+const (
+	AngleType Type = iota + 0x01
+	BooleanType
+	DurationType
+	GlyphType
+	MomentType
+	NumberType
+	PercentageType
+	ProbabilityType
+	ResourceType
+	IntervalType
+	RecurrenceType
+	DecimalType
+	RationalType
+	IntegerType
+)
+
 /*
 Units is a constrained type representing the possible units for an angle.
 */
@@ -55,6 +152,19 @@ const (
 AngleClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
 angle-like concrete class.
+
+The precision policy of a class determines how each new angle is locked onto a
+multiple of π/2 and how the results of the trigonometric functions and the
+Format() function are rounded.  The shared class always uses the locked
+precision, which uses the single precision range, and the WithPrecision()
+function returns a separate class that the caller owns.  The exact precision
+never locks or rounds, and the significant precision uses the significant
+digits of the class.  An invalid precision or a number of significant digits
+outside of 1 to 17 is returned as an error.
+
+Two angles are approximately equal when the shorter arc between them is within
+the tolerance, so ~0.01 and ~6.28 are approximately equal with a tolerance of
+~0.02.
 */
 type AngleClassLike interface {
 	// Constructor Methods
@@ -64,6 +174,12 @@ type AngleClassLike interface {
 	AngleFromSource(
 		source string,
 	) AngleLike
+	ParseAngle(
+		source string,
+	) (
+		angle AngleLike,
+		err error,
+	)
 
 	// Constant Methods
 	Undefined() AngleLike
@@ -72,6 +188,18 @@ type AngleClassLike interface {
 	Tau() AngleLike
 
 	// Function Methods
+	WithPrecision(
+		precision Precision,
+		digits uint,
+	) (
+		class AngleClassLike,
+		err error,
+	)
+	GetPrecision() Precision
+	GetSignificantDigits() uint
+	Format(
+		angle AngleLike,
+	) string
 	Inverse(
 		angle AngleLike,
 	) AngleLike
@@ -115,6 +243,15 @@ type AngleClassLike interface {
 		x float64,
 		y float64,
 	) AngleLike
+	Compare(
+		first AngleLike,
+		second AngleLike,
+	) int
+	ApproximatelyEqual(
+		first AngleLike,
+		second AngleLike,
+		tolerance AngleLike,
+	) bool
 }
 
 /*
@@ -130,6 +267,12 @@ type BooleanClassLike interface {
 	BooleanFromSource(
 		source string,
 	) BooleanLike
+	ParseBoolean(
+		source string,
+	) (
+		boolean BooleanLike,
+		err error,
+	)
 
 	// Constant Methods
 	False() BooleanLike
@@ -157,19 +300,170 @@ type BooleanClassLike interface {
 	) BooleanLike
 }
 
+/*
+CborClassLike is a class interface that defines the complete set of class
+constants, constructors and functions that must be supported by each cbor-like
+concrete class.
+
+It encodes and decodes the subset of CBOR (RFC 8949) that is needed to represent
+the primitives: integers, floats, byte strings, text strings, arrays, simple
+values and a single leading semantic tag (where zero means untagged).  The
+decoded values are bool, uint64, int64, float64, []byte, string, []any and nil.
+
+Each primitive maps onto CBOR as follows:
+  - booleans, real numbers, binaries and quotes are untagged native values
+  - moments use tag 1 with the (possibly fractional) seconds since the epoch,
+    unless they have sub-millisecond precision
+  - integers use tags 2 and 3 (bignums) with their magnitude as a byte string
+  - resources use tag 32 with the URI text
+  - patterns use tag 35 with the regular expression text
+  - all other primitives use a private tag of the form 0x636467XX, where XX is
+    the type byte of the class, with the complex numbers as a two element
+    array, the durations and moments having sub-millisecond precision as an
+    array of their milliseconds and nanoseconds, the intervals as an array of
+    their start and end milliseconds, the tags as a byte string, the versions
+    as an array of ordinals, the vectors as an array of [real, imaginary]
+    pairs, the matrices as an array of such arrays (one per row) and the
+    symbols, recurrences, decimals and rationals as text
+*/
+type CborClassLike interface {
+	// Function Methods
+	Encode(
+		tag uint64,
+		value any,
+	) (
+		bytes []byte,
+		err error,
+	)
+	Decode(
+		bytes []byte,
+	) (
+		tag uint64,
+		value any,
+		err error,
+	)
+}
+
+/*
+DecimalClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+decimal-like concrete class.
+
+A decimal is an arbitrary precision decimal number with a scale—the number of
+digits after its decimal point—making it suitable for monetary amounts.  Its
+source form ends with a "d" suffix (e.g. "19.99d") and retains any trailing
+zeros since they determine its scale.  Unlike a number, a decimal is always
+finite and defined.
+
+The sum, difference, product and remainder of two decimals are exact.  The
+scale of a sum, difference or remainder is the larger of the two scales and the
+scale of a product is the sum of the two scales.  A quotient is rounded to the
+scale using the rounding mode that are passed to the Quotient() function, and
+the Rounded() function also takes the rounding mode that it uses.  The
+DecimalFromFloat() constructor always uses half-even rounding.  Dividing by a
+zero decimal or passing an invalid rounding mode panics.
+*/
+type DecimalClassLike interface {
+	// Constructor Methods
+	Decimal(
+		unscaled *big.Int,
+		scale uint,
+	) DecimalLike
+	DecimalFromInteger(
+		integer int,
+	) DecimalLike
+	DecimalFromFloat(
+		float float64,
+		scale uint,
+	) DecimalLike
+	DecimalFromSource(
+		source string,
+	) DecimalLike
+	ParseDecimal(
+		source string,
+	) (
+		decimal DecimalLike,
+		err error,
+	)
+
+	// Constant Methods
+	Zero() DecimalLike
+	One() DecimalLike
+
+	// Function Methods
+	Inverse(
+		decimal DecimalLike,
+	) DecimalLike
+	Sum(
+		first DecimalLike,
+		second DecimalLike,
+	) DecimalLike
+	Difference(
+		first DecimalLike,
+		second DecimalLike,
+	) DecimalLike
+	Product(
+		first DecimalLike,
+		second DecimalLike,
+	) DecimalLike
+	Quotient(
+		first DecimalLike,
+		second DecimalLike,
+		scale uint,
+		rounding Rounding,
+	) DecimalLike
+	Remainder(
+		first DecimalLike,
+		second DecimalLike,
+	) DecimalLike
+	Rounded(
+		decimal DecimalLike,
+		scale uint,
+		rounding Rounding,
+	) DecimalLike
+}
+
 /*
 DurationClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
 duration-like concrete class.
+
+A duration may be negative (e.g. "~-P3D") so that the direction of an offset
+between two moments is preserved.  The factors of a negative duration (e.g.
+GetDays()) are those of its magnitude.
+
+A duration has nanosecond precision.  The seconds in its source form may have
+up to nine fractional digits (e.g. "~PT0.000250S"), and the nanoseconds within
+its last millisecond are available from the GetMicroseconds() and
+GetNanoseconds() methods.  Its intrinsic value is the whole number of
+milliseconds, truncated toward zero, so a duration that is created from
+milliseconds converts back to them without loss.
+
+A duration converts to and from a Go time.Duration without loss, except that
+the AsTimeDuration() method saturates at the minimum or maximum time.Duration
+for a duration that is longer than about 292 years.
 */
 type DurationClassLike interface {
 	// Constructor Methods
 	Duration(
-		milliseconds uint,
+		milliseconds int,
+	) DurationLike
+	DurationWithNanoseconds(
+		milliseconds int,
+		nanoseconds int,
+	) DurationLike
+	DurationFromTimeDuration(
+		duration tim.Duration,
 	) DurationLike
 	DurationFromSource(
 		source string,
 	) DurationLike
+	ParseDuration(
+		source string,
+	) (
+		duration DurationLike,
+		err error,
+	)
 
 	// Constant Methods
 	MillisecondsPerSecond() uint
@@ -182,6 +476,82 @@ type DurationClassLike interface {
 	DaysPerMonth() float64
 	DaysPerYear() float64
 	WeeksPerMonth() float64
+
+	// Function Methods
+	Sum(
+		first DurationLike,
+		second DurationLike,
+	) DurationLike
+	Difference(
+		first DurationLike,
+		second DurationLike,
+	) DurationLike
+	Scaled(
+		duration DurationLike,
+		factor float64,
+	) DurationLike
+	Quotient(
+		first DurationLike,
+		second DurationLike,
+	) float64
+	Minimum(
+		first DurationLike,
+		second DurationLike,
+	) DurationLike
+	Maximum(
+		first DurationLike,
+		second DurationLike,
+	) DurationLike
+	Compare(
+		first DurationLike,
+		second DurationLike,
+	) int
+	ApproximatelyEqual(
+		first DurationLike,
+		second DurationLike,
+		tolerance DurationLike,
+	) bool
+}
+
+/*
+FormatClassLike is a class interface that defines the complete set of class
+constants, constructors and functions that must be supported by each
+format-like concrete class.
+
+The binary encoding of each primitive begins with a header containing the
+version of the binary format followed by the type byte for the class of the
+primitive.  The remaining bytes contain the payload, whose layout depends on
+the class:
+  - angles, percentages and probabilities are IEEE 754 float64 values
+  - numbers are one (real) or two (complex) IEEE 754 float64 values
+  - durations and moments are a signed varint of milliseconds, followed by a
+    signed varint of nanoseconds when they have sub-millisecond precision
+  - intervals are two signed varints of milliseconds
+  - version ordinals are a sequence of unsigned varints
+  - bytecode instructions are a sequence of uint16 values
+  - vectors are a sequence of IEEE 754 float64 real and imaginary pairs
+  - matrices are an unsigned varint column count followed by the pairs of
+    their elements in row-major order
+  - binaries and tags are their raw bytes
+  - everything else is its source, encoded as UTF-8
+
+All fixed size values are big-endian.
+*/
+type FormatClassLike interface {
+	// Constant Methods
+	Version() uint8
+
+	// Function Methods
+	Header(
+		type_ Type,
+	) []byte
+	Payload(
+		type_ Type,
+		bytes []byte,
+	) (
+		payload []byte,
+		err error,
+	)
 }
 
 /*
@@ -200,6 +570,12 @@ type GlyphClassLike interface {
 	GlyphFromSource(
 		source string,
 	) GlyphLike
+	ParseGlyph(
+		source string,
+	) (
+		glyph GlyphLike,
+		err error,
+	)
 
 	// Constant Methods
 	Undefined() GlyphLike
@@ -213,19 +589,289 @@ type GlyphClassLike interface {
 	) GlyphLike
 }
 
+/*
+IntegerClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+integer-like concrete class.
+
+An integer is an arbitrary precision integer whose source form ends with an "n"
+suffix (e.g. "-42n").  It may also be written in base 2, 8, 16, 32 or 64 using
+a radix prefix (e.g. "2r101010n", "8r52n", "16r2An", "32r1An" or "64rqn").  The
+base 32 digits are the same as those used by tags and the base 64 digits are
+those defined in RFC 4648, with each digit having the value of its position in
+the alphabet.  The canonical source form of an integer is always in base 10.
+
+The quotient and remainder of two integers are truncated toward zero, as they
+are in Go, and dividing by a zero integer panics.  The bitwise functions treat
+a negative integer as if it were in two's complement form with an infinite
+number of leading ones.
+*/
+type IntegerClassLike interface {
+	// Constructor Methods
+	Integer(
+		integer int,
+	) IntegerLike
+	IntegerFromBigInt(
+		integer *big.Int,
+	) IntegerLike
+	IntegerFromSource(
+		source string,
+	) IntegerLike
+	ParseInteger(
+		source string,
+	) (
+		integer IntegerLike,
+		err error,
+	)
+
+	// Constant Methods
+	Zero() IntegerLike
+	One() IntegerLike
+
+	// Function Methods
+	Inverse(
+		integer IntegerLike,
+	) IntegerLike
+	Sum(
+		first IntegerLike,
+		second IntegerLike,
+	) IntegerLike
+	Difference(
+		first IntegerLike,
+		second IntegerLike,
+	) IntegerLike
+	Product(
+		first IntegerLike,
+		second IntegerLike,
+	) IntegerLike
+	Quotient(
+		first IntegerLike,
+		second IntegerLike,
+	) IntegerLike
+	Remainder(
+		first IntegerLike,
+		second IntegerLike,
+	) IntegerLike
+	Power(
+		base IntegerLike,
+		exponent uint,
+	) IntegerLike
+	ModularPower(
+		base IntegerLike,
+		exponent IntegerLike,
+		modulus IntegerLike,
+	) IntegerLike
+	Gcd(
+		first IntegerLike,
+		second IntegerLike,
+	) IntegerLike
+	SquareRoot(
+		integer IntegerLike,
+	) IntegerLike
+	Not(
+		integer IntegerLike,
+	) IntegerLike
+	And(
+		first IntegerLike,
+		second IntegerLike,
+	) IntegerLike
+	San(
+		first IntegerLike,
+		second IntegerLike,
+	) IntegerLike
+	Ior(
+		first IntegerLike,
+		second IntegerLike,
+	) IntegerLike
+	Xor(
+		first IntegerLike,
+		second IntegerLike,
+	) IntegerLike
+	ShiftLeft(
+		integer IntegerLike,
+		bits uint,
+	) IntegerLike
+	ShiftRight(
+		integer IntegerLike,
+		bits uint,
+	) IntegerLike
+}
+
+/*
+IntervalClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+interval-like concrete class.
+
+An interval is the half-open range of moments that starts with its start moment
+and ends just before its end moment.  Its canonical source form delimits the
+two moments with ".." (e.g. "<2024-01-01>..<2024-02-01>"), and it may also be
+specified using the ISO 8601 start and duration form (e.g. "<2024-01-01>/~P1W").
+
+The Intersection() and Union() functions report false when the result would
+not be a single interval, and the Gap() function reports false when the
+intervals overlap or abut each other.  The Split() and Subintervals() functions divide an
+interval into consecutive subintervals of the specified duration, the last of
+which may be shorter.  The Subintervals() function calculates each subinterval
+as it is iterated over.  Since the bounds of an interval are whole milliseconds,
+the bounds of the subintervals are truncated to the millisecond, and both
+functions panic if the duration is shorter than one millisecond.
+*/
+type IntervalClassLike interface {
+	// Constructor Methods
+	Interval(
+		start MomentLike,
+		end MomentLike,
+	) IntervalLike
+	IntervalFromDuration(
+		start MomentLike,
+		duration DurationLike,
+	) IntervalLike
+	IntervalFromSource(
+		source string,
+	) IntervalLike
+	ParseInterval(
+		source string,
+	) (
+		interval IntervalLike,
+		err error,
+	)
+
+	// Function Methods
+	Contains(
+		interval IntervalLike,
+		moment MomentLike,
+	) bool
+	Overlaps(
+		first IntervalLike,
+		second IntervalLike,
+	) bool
+	Intersection(
+		first IntervalLike,
+		second IntervalLike,
+	) (
+		intersection IntervalLike,
+		ok bool,
+	)
+	Union(
+		first IntervalLike,
+		second IntervalLike,
+	) (
+		union IntervalLike,
+		ok bool,
+	)
+	Gap(
+		first IntervalLike,
+		second IntervalLike,
+	) (
+		gap IntervalLike,
+		ok bool,
+	)
+	Split(
+		interval IntervalLike,
+		duration DurationLike,
+	) []IntervalLike
+	Subintervals(
+		interval IntervalLike,
+		duration DurationLike,
+	) uti.Ratcheted[IntervalLike]
+}
+
+/*
+JsonClassLike is a class interface that defines the complete set of class
+constants, constructors and functions that must be supported by each json-like
+concrete class.
+
+The marshaling and unmarshaling functions of this class take the encoding to be
+used on each call, so different callers may use different encodings at the same
+time.  The JSON marshaling methods of each primitive (i.e. MarshalJSON and
+UnmarshalJSON) always use the source encoding.  Those primitives that have a
+native JSON representation also provide MarshalNativeJSON and
+UnmarshalNativeJSON methods which are used by this class for the native
+encoding.  A primitive that is passed to the Unmarshal function must be a
+pointer to a concrete primitive.
+
+In the native encoding, values that have no native JSON representation are
+encoded using their source form instead, and are accepted in that form when
+decoded.  This includes every rational and recurrence, an undefined or
+infinite number, and a moment or duration whose value has a sub-millisecond
+part, since a native moment or duration is a whole number of milliseconds.
+*/
+type JsonClassLike interface {
+	// Function Methods
+	Marshal(
+		primitive any,
+		encoding Encoding,
+	) (
+		bytes []byte,
+		err error,
+	)
+	Unmarshal(
+		bytes []byte,
+		encoding Encoding,
+		primitive any,
+	) (
+		err error,
+	)
+}
+
 /*
 MomentClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
 moment-like concrete class.
+
+The source form of a moment may end with a time zone that is either "Z", a UTC
+offset (e.g. "<2024-05-01T12+05:30>") or a bracketed IANA zone name (e.g.
+"<2024-05-01T12[Europe/Paris]>").  The moment is normalized to UTC so its
+canonical source form never includes a time zone.  The FactorsInZone() function
+renders the factors of a moment in any of these time zones using an embedded
+copy of the IANA time zone database.
+
+Unlike the Earlier() and Later() functions, which add an exact duration, the
+AddXxx() functions use calendar arithmetic.  Adding months or years to a moment
+keeps its time of day and clamps its day to the end of the resulting month
+(e.g. one month after January 31st is the last day of February).
+
+A moment has nanosecond precision.  Its seconds may have up to nine fractional
+digits (e.g. "<2024-05-01T12:00:00.000250>"), and the nanoseconds within its
+last millisecond are available from the GetMicroseconds() and GetNanoseconds()
+methods.  Its intrinsic value is the whole number of milliseconds since the
+epoch, rounded down, so a moment that is created from milliseconds converts
+back to them without loss.  Intervals and recurrences have millisecond
+precision.
+
+A moment converts to and from a Go time.Time without loss of precision.  The
+time zone and any monotonic clock reading of a time.Time are dropped, and the
+AsTime() method always returns a time in UTC.
+
+The date of a moment may also be written as an ISO 8601 week date (e.g.
+"<2024-W05-3>" is the Wednesday of the fifth week of 2024) or as an ordinal date
+(e.g. "<2024-123>" is the 123rd day of 2024).  The first week of an ISO
+week-year is the one containing its first Thursday, so the week-year of a moment
+near the start or end of a calendar year may differ from its calendar year.  The
+AsWeekDate() and AsOrdinalDate() methods render a moment in these forms, but its
+canonical source form always uses a calendar date.
 */
 type MomentClassLike interface {
 	// Constructor Methods
 	Moment(
 		milliseconds int,
 	) MomentLike
+	MomentWithNanoseconds(
+		milliseconds int,
+		nanoseconds int,
+	) MomentLike
+	MomentFromTime(
+		time tim.Time,
+	) MomentLike
 	MomentFromSource(
 		source string,
 	) MomentLike
+	ParseMoment(
+		source string,
+	) (
+		moment MomentLike,
+		err error,
+	)
 
 	// Constant Methods
 	Epoch() MomentLike
@@ -244,12 +890,95 @@ type MomentClassLike interface {
 		first MomentLike,
 		second MomentLike,
 	) DurationLike
+	Offset(
+		first MomentLike,
+		second MomentLike,
+	) DurationLike
+	FactorsInZone(
+		moment MomentLike,
+		zone string,
+	) (
+		factors Factored,
+		err error,
+	)
+	AddYears(
+		moment MomentLike,
+		years int,
+	) MomentLike
+	AddMonths(
+		moment MomentLike,
+		months int,
+	) MomentLike
+	AddWeeks(
+		moment MomentLike,
+		weeks int,
+	) MomentLike
+	AddDays(
+		moment MomentLike,
+		days int,
+	) MomentLike
+	Truncated(
+		moment MomentLike,
+		period Period,
+	) MomentLike
+	Rounded(
+		moment MomentLike,
+		period Period,
+	) MomentLike
+	Compare(
+		first MomentLike,
+		second MomentLike,
+	) int
+	ApproximatelyEqual(
+		first MomentLike,
+		second MomentLike,
+		tolerance DurationLike,
+	) bool
 }
 
 /*
 NumberClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
 number-like concrete class.
+
+The source form of a number may also write each of its parts in hexadecimal,
+octal or binary (e.g. "0x1F", "0o17" or "0b1010"), as a hexadecimal floating
+point value (e.g. "0x1.8p3"), or in engineering notation with a metric prefix
+(e.g. "4.7k" or "10µ").  The exa prefix is not supported since it would be
+mistaken for an exponent.  The AsBase() method renders a number in base 2, 8,
+10 or 16, falling back to base 10 for any part that is not an integer in base
+2 or 8, and the AsEngineering() method renders it in engineering notation.
+
+A number converts to and from the math/big types as a float64 value.  The
+NumberFromBigFloat() and NumberFromBigRat() constructors round to the nearest
+float64 value, and the AsBigFloat() and AsBigRat() methods convert the real
+part exactly but return nil for a number that is not a finite real number.
+
+The transcendental functions are defined over the entire complex plane and
+return the principal value of each multivalued function.  The NthRoots()
+function instead returns all n roots, starting with the principal root and
+proceeding counterclockwise, and it panics if the degree is zero since there is
+no zeroth root.  Like the Power() function, their results are
+locked onto exact values where possible, so the sine of π is exactly zero and
+the cosine of π is exactly -1.  The gamma function is infinite at zero and at
+each negative integer.
+
+The precision policy of a class determines how these results are locked and
+how its Format functions render the rectangular, polar and engineering source
+forms.  The shared class always uses the locked precision, which uses the
+single precision range, and the WithPrecision() function returns a separate
+class that the caller owns.  The exact precision never locks and renders the
+shortest source that parses back to the exact value, and the significant
+precision rounds to the significant digits of the class.  An invalid precision
+or a number of significant digits outside of 1 to 17 is returned as an error.
+The methods of each number always use the shared class.
+
+The Compare() function defines a total ordering of numbers by magnitude and
+then by angle in the range [0..τ), so 1 comes before i, -1 and -i.  An
+undefined number comes before every other number, and the infinities come
+after every finite number in the order +∞, -∞ and then ∞.  Two numbers are
+approximately equal when the distance between them in the complex plane is
+within the tolerance.
 */
 type NumberClassLike interface {
 	// Constructor Methods
@@ -270,9 +999,21 @@ type NumberClassLike interface {
 	NumberFromFloat(
 		float float64,
 	) NumberLike
+	NumberFromBigFloat(
+		float *big.Float,
+	) NumberLike
+	NumberFromBigRat(
+		rational *big.Rat,
+	) NumberLike
 	NumberFromSource(
 		source string,
 	) NumberLike
+	ParseNumber(
+		source string,
+	) (
+		number NumberLike,
+		err error,
+	)
 
 	// Constant Methods
 	Undefined() NumberLike
@@ -288,6 +1029,24 @@ type NumberClassLike interface {
 	Infinity() NumberLike
 
 	// Function Methods
+	WithPrecision(
+		precision Precision,
+		digits uint,
+	) (
+		class NumberClassLike,
+		err error,
+	)
+	GetPrecision() Precision
+	GetSignificantDigits() uint
+	FormatRectangular(
+		number NumberLike,
+	) string
+	FormatPolar(
+		number NumberLike,
+	) string
+	FormatEngineering(
+		number NumberLike,
+	) string
 	Inverse(
 		number NumberLike,
 	) NumberLike
@@ -329,6 +1088,95 @@ type NumberClassLike interface {
 		base NumberLike,
 		number NumberLike,
 	) NumberLike
+	Compare(
+		first NumberLike,
+		second NumberLike,
+	) int
+	ApproximatelyEqual(
+		first NumberLike,
+		second NumberLike,
+		tolerance float64,
+	) bool
+	Absolute(
+		number NumberLike,
+	) NumberLike
+	SquareRoot(
+		number NumberLike,
+	) NumberLike
+	NthRoots(
+		number NumberLike,
+		degree uint,
+	) []NumberLike
+	Exponential(
+		number NumberLike,
+	) NumberLike
+	Gamma(
+		number NumberLike,
+	) NumberLike
+	Sine(
+		number NumberLike,
+	) NumberLike
+	ArcSine(
+		number NumberLike,
+	) NumberLike
+	Cosine(
+		number NumberLike,
+	) NumberLike
+	ArcCosine(
+		number NumberLike,
+	) NumberLike
+	Tangent(
+		number NumberLike,
+	) NumberLike
+	ArcTangent(
+		number NumberLike,
+	) NumberLike
+	HyperbolicSine(
+		number NumberLike,
+	) NumberLike
+	ArcHyperbolicSine(
+		number NumberLike,
+	) NumberLike
+	HyperbolicCosine(
+		number NumberLike,
+	) NumberLike
+	ArcHyperbolicCosine(
+		number NumberLike,
+	) NumberLike
+	HyperbolicTangent(
+		number NumberLike,
+	) NumberLike
+	ArcHyperbolicTangent(
+		number NumberLike,
+	) NumberLike
+}
+
+/*
+ParseErrorClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+parse-error-like concrete class.
+
+A parse error is returned by each Parse method when the specified source string
+does not match the source form for its primitive type.  It records the longest
+prefix of the source string that did match, where the matching stopped, and a
+description of the grammar production that was expected at that point.  The
+productions map passed to the matcher based constructor associates the capture
+group indices of the matcher with their production descriptions.
+*/
+type ParseErrorClassLike interface {
+	// Constructor Methods
+	ParseError(
+		kind string,
+		source string,
+		offset uint,
+		expected string,
+	) ParseErrorLike
+	ParseErrorFromMatcher(
+		kind string,
+		matcher *reg.Regexp,
+		productions map[int]string,
+		source string,
+	) ParseErrorLike
 }
 
 /*
@@ -347,9 +1195,26 @@ type PercentageClassLike interface {
 	PercentageFromSource(
 		source string,
 	) PercentageLike
+	ParsePercentage(
+		source string,
+	) (
+		percentage PercentageLike,
+		err error,
+	)
 
 	// Constant Methods
 	Undefined() PercentageLike
+
+	// Function Methods
+	Compare(
+		first PercentageLike,
+		second PercentageLike,
+	) int
+	ApproximatelyEqual(
+		first PercentageLike,
+		second PercentageLike,
+		tolerance PercentageLike,
+	) bool
 }
 
 /*
@@ -368,6 +1233,12 @@ type ProbabilityClassLike interface {
 	ProbabilityFromSource(
 		source string,
 	) ProbabilityLike
+	ParseProbability(
+		source string,
+	) (
+		probability ProbabilityLike,
+		err error,
+	)
 
 	// Constant Methods
 	Undefined() ProbabilityLike
@@ -393,12 +1264,154 @@ type ProbabilityClassLike interface {
 		first ProbabilityLike,
 		second ProbabilityLike,
 	) ProbabilityLike
+	Compare(
+		first ProbabilityLike,
+		second ProbabilityLike,
+	) int
+	ApproximatelyEqual(
+		first ProbabilityLike,
+		second ProbabilityLike,
+		tolerance ProbabilityLike,
+	) bool
+}
+
+/*
+RationalClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+rational-like concrete class.
+
+A rational is an exact ratio of two arbitrary precision integers that is always
+reduced to lowest terms with a positive denominator (e.g. "6/8" becomes "3/4").
+Its source form always includes the denominator so an integer is written with a
+denominator of one (e.g. "5/1").  Like a decimal, a rational is always finite
+and defined, and dividing by a zero rational panics.
+
+A number, percentage or probability is converted to the rational for the
+shortest decimal that rounds to its floating point value (e.g. "p0.1" becomes
+"1/10"), rather than to the exact binary value of the floating point number.
+The Approximation() function instead finds the closest rational to a floating
+point value whose denominator is within a limit (e.g. π with a limit of 1000 is
+"355/113") using its continued fraction expansion.  A rational that is converted
+to a probability is clamped to the range [0..1].
+*/
+type RationalClassLike interface {
+	// Constructor Methods
+	Rational(
+		numerator int,
+		denominator int,
+	) RationalLike
+	RationalFromBigRat(
+		rational *big.Rat,
+	) RationalLike
+	RationalFromNumber(
+		number NumberLike,
+	) RationalLike
+	RationalFromPercentage(
+		percentage PercentageLike,
+	) RationalLike
+	RationalFromProbability(
+		probability ProbabilityLike,
+	) RationalLike
+	RationalFromSource(
+		source string,
+	) RationalLike
+	ParseRational(
+		source string,
+	) (
+		rational RationalLike,
+		err error,
+	)
+
+	// Constant Methods
+	Zero() RationalLike
+	One() RationalLike
+
+	// Function Methods
+	Inverse(
+		rational RationalLike,
+	) RationalLike
+	Reciprocal(
+		rational RationalLike,
+	) RationalLike
+	Sum(
+		first RationalLike,
+		second RationalLike,
+	) RationalLike
+	Difference(
+		first RationalLike,
+		second RationalLike,
+	) RationalLike
+	Product(
+		first RationalLike,
+		second RationalLike,
+	) RationalLike
+	Quotient(
+		first RationalLike,
+		second RationalLike,
+	) RationalLike
+	Power(
+		base RationalLike,
+		exponent int,
+	) RationalLike
+	Approximation(
+		float float64,
+		limit uint,
+	) RationalLike
+}
+
+/*
+RecurrenceClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+recurrence-like concrete class.
+
+A recurrence may be specified as an ISO 8601 repeating interval consisting of an
+optional number of occurrences, a start moment and a positive duration (e.g.
+"R5/<2024-01-01T09>/~P1W"), or as a start moment followed by a subset of an RFC
+5545 recurrence rule supporting the FREQ, INTERVAL, COUNT, UNTIL, BYDAY and
+BYMONTHDAY rule parts (e.g. "R/<2024-01-01T09>/FREQ=WEEKLY;BYDAY=MO,WE").
+Recurrence rules are evaluated in UTC with weeks starting on Monday, and an
+UNTIL bound is inclusive.  A count of zero passed to the Recurrence() constructor
+creates an unbounded repeating interval.
+
+The Occurrences() function iterates over the occurrences of any recurrence that
+fall within the specified interval, whereas only a bounded recurrence may be
+iterated over directly.  In both cases the occurrences are generated as they
+are iterated over.  A recurrence rule that has no occurrences at all (e.g.
+"R/<2024-01-01>/FREQ=DAILY;INTERVAL=7;BYDAY=TU" since the start is a Monday) is
+rejected when it is parsed.
+*/
+type RecurrenceClassLike interface {
+	// Constructor Methods
+	Recurrence(
+		start MomentLike,
+		duration DurationLike,
+		count uint,
+	) RecurrenceLike
+	RecurrenceFromSource(
+		source string,
+	) RecurrenceLike
+	ParseRecurrence(
+		source string,
+	) (
+		recurrence RecurrenceLike,
+		err error,
+	)
+
+	// Function Methods
+	Occurrences(
+		recurrence RecurrenceLike,
+		window IntervalLike,
+	) uti.Ratcheted[MomentLike]
 }
 
 /*
 ResourceClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
 resource-like concrete class.
+
+The ResourceFromUri() constructor panics if the URI is nil or is not a valid
+resource (e.g. it is relative), so any resource converts to and from a Go
+*url.URL without loss.
 */
 type ResourceClassLike interface {
 	// Constructor Methods
@@ -408,6 +1421,12 @@ type ResourceClassLike interface {
 	ResourceFromSource(
 		source string,
 	) ResourceLike
+	ParseResource(
+		source string,
+	) (
+		resource ResourceLike,
+		err error,
+	)
 	ResourceFromUri(
 		url *uri.URL,
 	) ResourceLike
@@ -438,6 +1457,7 @@ type AngleLike interface {
 
 	// Aspect Interfaces
 	Continuous
+	Ordered[AngleLike]
 }
 
 /*
@@ -455,6 +1475,23 @@ type BooleanLike interface {
 	Discrete
 }
 
+/*
+DecimalLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a decimal-like class.
+*/
+type DecimalLike interface {
+	// Principal Methods
+	GetClass() DecimalClassLike
+	GetUnscaled() *big.Int
+	GetScale() uint
+	AsBigRat() *big.Rat
+
+	// Aspect Interfaces
+	Continuous
+	Polarized
+}
+
 /*
 DurationLike is an instance interface that defines the complete set of
 instance attributes, abstractions and methods that must be supported by each
@@ -463,12 +1500,15 @@ instance of a duration-like class.
 type DurationLike interface {
 	// Principal Methods
 	GetClass() DurationClassLike
-	AsIntrinsic() uint
+	AsIntrinsic() int
+	AsTimeDuration() tim.Duration
 	AsSource() string
 
 	// Aspect Interfaces
 	Discrete
 	Factored
+	Ordered[DurationLike]
+	Polarized
 	Temporal
 }
 
@@ -487,6 +1527,47 @@ type GlyphLike interface {
 	Discrete
 }
 
+/*
+IntegerLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of an integer-like class.
+
+The AsInteger() method saturates at the limits of the Go int type, whereas the
+AsCheckedInteger() method reports whether the integer fits in a Go int.
+*/
+type IntegerLike interface {
+	// Principal Methods
+	GetClass() IntegerClassLike
+	AsBigInt() *big.Int
+	AsBase(
+		base uint,
+	) string
+	AsCheckedInteger() (
+		integer int,
+		ok bool,
+	)
+
+	// Aspect Interfaces
+	Discrete
+	Polarized
+}
+
+/*
+IntervalLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of an interval-like class.
+*/
+type IntervalLike interface {
+	// Principal Methods
+	GetClass() IntervalClassLike
+	AsIntrinsic() [2]int
+	AsSource() string
+	GetStart() MomentLike
+	GetEnd() MomentLike
+	GetDuration() DurationLike
+	IsEmpty() bool
+}
+
 /*
 MomentLike is an instance interface that defines the complete set of
 instance attributes, abstractions and methods that must be supported by each
@@ -496,11 +1577,18 @@ type MomentLike interface {
 	// Principal Methods
 	GetClass() MomentClassLike
 	AsIntrinsic() int
+	AsTime() tim.Time
 	AsSource() string
+	AsWeekDate() string
+	AsOrdinalDate() string
+	GetWeekYear() int
+	GetWeekday() uint
+	GetDayOfYear() uint
 
 	// Aspect Interfaces
 	Discrete
 	Factored
+	Ordered[MomentLike]
 	Polarized
 	Temporal
 }
@@ -516,17 +1604,44 @@ type NumberLike interface {
 	AsIntrinsic() complex128
 	AsRectangular() string
 	AsPolar() string
+	AsBase(
+		base uint,
+	) string
+	AsEngineering() string
 	AsSource() string
 	GetReal() float64
 	GetImaginary() float64
 	GetMagnitude() float64
 	GetAngle() float64
+	AsBigFloat() *big.Float
+	AsBigRat() *big.Rat
 
 	// Aspect Interfaces
 	Continuous
+	Ordered[NumberLike]
 	Polarized
 }
 
+/*
+ParseErrorLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a parse-error-like class.
+*/
+type ParseErrorLike interface {
+	// Principal Methods
+	GetClass() ParseErrorClassLike
+	Error() string
+
+	// Attribute Methods
+	GetKind() string
+	GetSource() string
+	GetOffset() uint
+	GetPrefix() string
+	GetLine() uint
+	GetColumn() uint
+	GetExpected() string
+}
+
 /*
 PercentageLike is an instance interface that defines the complete set of
 instance attributes, abstractions and methods that must be supported by each
@@ -540,6 +1655,7 @@ type PercentageLike interface {
 
 	// Aspect Interfaces
 	Continuous
+	Ordered[PercentageLike]
 	Polarized
 }
 
@@ -556,6 +1672,41 @@ type ProbabilityLike interface {
 
 	// Aspect Interfaces
 	Continuous
+	Ordered[ProbabilityLike]
+}
+
+/*
+RationalLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a rational-like class.
+*/
+type RationalLike interface {
+	// Principal Methods
+	GetClass() RationalClassLike
+	GetNumerator() *big.Int
+	GetDenominator() *big.Int
+	AsBigRat() *big.Rat
+	AsNumber() NumberLike
+	AsPercentage() PercentageLike
+	AsProbability() ProbabilityLike
+
+	// Aspect Interfaces
+	Continuous
+	Polarized
+}
+
+/*
+RecurrenceLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a recurrence-like class.
+*/
+type RecurrenceLike interface {
+	// Principal Methods
+	GetClass() RecurrenceClassLike
+	AsSource() string
+	GetStart() MomentLike
+	IsBounded() bool
+	GetIterator() uti.Ratcheted[MomentLike]
 }
 
 /*
@@ -611,6 +1762,8 @@ Factored is an aspect interface that defines a set of method signatures
 that must be supported by each instance of a factored class.
 */
 type Factored interface {
+	GetNanoseconds() uint
+	GetMicroseconds() uint
 	GetMilliseconds() uint
 	GetSeconds() uint
 	GetMinutes() uint
@@ -621,6 +1774,17 @@ type Factored interface {
 	GetYears() uint
 }
 
+/*
+Ordered[V any] is an aspect interface that defines a set of method signatures
+that must be supported by each instance of an ordered class.
+*/
+type Ordered[V any] interface {
+	AsSource() string
+	IsBefore(
+		value V,
+	) bool
+}
+
 /*
 Polarized is an aspect interface that defines a set of method signatures
 that must be supported by each instance of a polarized class.
@@ -0,0 +1,120 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+Package "grammar" contains the parsing support that is shared by the elements
+and sequences packages and the module API.  Since the package is internal none
+of it is part of the public interface of the module.
+*/
+package grammar
+
+import (
+	fmt "fmt"
+	reg "regexp"
+	sli "slices"
+)
+
+// NOTE:
+// This function records the matchers of the specified kinds of primitive so
+// that the other packages in this module can scan for those kinds of primitive
+// without the matchers being part of the public interface of their classes.
+func RegisterMatchers(
+	matchers map[string]*reg.Regexp,
+) {
+	for kind, matcher := range matchers {
+		matchers_[kind] = matcher
+	}
+}
+
+// This function returns the matcher that was registered for the specified kind
+// of primitive.
+func Matcher(
+	kind string,
+) *reg.Regexp {
+	var matcher, ok = matchers_[kind]
+	if !ok {
+		var message = fmt.Sprintf(
+			"No matcher was registered for the kind of primitive: %v",
+			kind,
+		)
+		panic(message)
+	}
+	return matcher
+}
+
+// NOTE:
+// This function returns the submatches of the specified matcher when it
+// matches the entire source string, or nil when it matches only a prefix of
+// the source string or none of it.  The class matchers are anchored only to
+// the start of the source since they are also used to scan a primitive from
+// the front of a longer source.
+func MatchSource(
+	matcher *reg.Regexp,
+	source string,
+) []string {
+	var matches = matcher.FindStringSubmatch(source)
+	if matches == nil || len(matches[0]) != len(source) {
+		return nil
+	}
+	return matches
+}
+
+// This function returns the submatch indices of the specified matcher when it
+// matches the entire source string, or nil otherwise.
+func MatchSourceIndex(
+	matcher *reg.Regexp,
+	source string,
+) []int {
+	var indices = matcher.FindStringSubmatchIndex(source)
+	if indices == nil || indices[1] != len(source) {
+		return nil
+	}
+	return indices
+}
+
+// This function returns the kinds of primitive in order of precedence.  When
+// several kinds of primitive match the same source string the first of them in
+// this order is the one that is parsed.
+func PrimitivePrecedence() []string {
+	return sli.Clone(primitivePrecedence_)
+}
+
+var matchers_ = map[string]*reg.Regexp{}
+
+var primitivePrecedence_ = []string{
+	"duration",
+	"angle",
+	"moment",
+	"interval",
+	"resource",
+	"tag",
+	"symbol",
+	"name",
+	"binary",
+	"bytecode",
+	"glyph",
+	"narrative",
+	"quote",
+	"pattern",
+	"recurrence",
+	"boolean",
+	"version",
+	"probability",
+	"percentage",
+	"decimal",
+	"rational",
+	"integer",
+	"number",
+	"identifier",
+	"matrix",
+	"vector",
+}
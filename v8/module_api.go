@@ -31,15 +31,89 @@ For detailed documentation on this entire module refer to the wiki:
 package module
 
 import (
+	enc "encoding"
+	jsn "encoding/json"
+	fmt "fmt"
 	ele "github.com/craterdog/go-essential-primitives/v8/elements"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	seq "github.com/craterdog/go-essential-primitives/v8/sequences"
+	io "io"
+	big "math/big"
 	uri "net/url"
+	ref "reflect"
+	reg "regexp"
+	sts "strings"
+	tim "time"
 )
 
 // TYPE ALIASES
 
 // Elements
 
+type (
+	Encoding = ele.Encoding
+)
+
+const (
+	SourceEncoding = ele.SourceEncoding
+	NativeEncoding = ele.NativeEncoding
+)
+
+type (
+	Period = ele.Period
+)
+
+const (
+	DayPeriod     = ele.DayPeriod
+	WeekPeriod    = ele.WeekPeriod
+	MonthPeriod   = ele.MonthPeriod
+	QuarterPeriod = ele.QuarterPeriod
+	YearPeriod    = ele.YearPeriod
+)
+
+type (
+	Precision = ele.Precision
+)
+
+const (
+	LockedPrecision      = ele.LockedPrecision
+	ExactPrecision       = ele.ExactPrecision
+	SignificantPrecision = ele.SignificantPrecision
+)
+
+type (
+	Rounding = ele.Rounding
+)
+
+const (
+	HalfEvenRounding = ele.HalfEvenRounding
+	HalfUpRounding   = ele.HalfUpRounding
+	DownRounding     = ele.DownRounding
+	CeilingRounding  = ele.CeilingRounding
+	FloorRounding    = ele.FloorRounding
+)
+
+type (
+	Type = ele.Type
+)
+
+const (
+	AngleType       = ele.AngleType
+	BooleanType     = ele.BooleanType
+	DurationType    = ele.DurationType
+	GlyphType       = ele.GlyphType
+	MomentType      = ele.MomentType
+	NumberType      = ele.NumberType
+	PercentageType  = ele.PercentageType
+	ProbabilityType = ele.ProbabilityType
+	ResourceType    = ele.ResourceType
+	IntervalType    = ele.IntervalType
+	RecurrenceType  = ele.RecurrenceType
+	DecimalType     = ele.DecimalType
+	RationalType    = ele.RationalType
+	IntegerType     = ele.IntegerType
+)
+
 type (
 	Units = ele.Units
 )
@@ -53,47 +127,81 @@ const (
 type (
 	AngleClassLike       = ele.AngleClassLike
 	BooleanClassLike     = ele.BooleanClassLike
+	CborClassLike        = ele.CborClassLike
+	DecimalClassLike     = ele.DecimalClassLike
 	DurationClassLike    = ele.DurationClassLike
+	FormatClassLike      = ele.FormatClassLike
 	GlyphClassLike       = ele.GlyphClassLike
+	IntegerClassLike     = ele.IntegerClassLike
+	IntervalClassLike    = ele.IntervalClassLike
+	JsonClassLike        = ele.JsonClassLike
 	MomentClassLike      = ele.MomentClassLike
 	NumberClassLike      = ele.NumberClassLike
+	ParseErrorClassLike  = ele.ParseErrorClassLike
 	PercentageClassLike  = ele.PercentageClassLike
 	ProbabilityClassLike = ele.ProbabilityClassLike
+	RationalClassLike    = ele.RationalClassLike
+	RecurrenceClassLike  = ele.RecurrenceClassLike
 	ResourceClassLike    = ele.ResourceClassLike
 )
 
 type (
 	AngleLike       = ele.AngleLike
 	BooleanLike     = ele.BooleanLike
+	DecimalLike     = ele.DecimalLike
 	DurationLike    = ele.DurationLike
 	GlyphLike       = ele.GlyphLike
+	IntegerLike     = ele.IntegerLike
+	IntervalLike    = ele.IntervalLike
 	MomentLike      = ele.MomentLike
 	NumberLike      = ele.NumberLike
+	ParseErrorLike  = ele.ParseErrorLike
 	PercentageLike  = ele.PercentageLike
 	ProbabilityLike = ele.ProbabilityLike
+	RationalLike    = ele.RationalLike
+	RecurrenceLike  = ele.RecurrenceLike
 	ResourceLike    = ele.ResourceLike
 )
 
 type (
-	Continuous = ele.Continuous
-	Discrete   = ele.Discrete
-	Factored   = ele.Factored
-	Polarized  = ele.Polarized
-	Temporal   = ele.Temporal
+	Continuous     = ele.Continuous
+	Discrete       = ele.Discrete
+	Factored       = ele.Factored
+	Ordered[V any] = ele.Ordered[V]
+	Polarized      = ele.Polarized
+	Temporal       = ele.Temporal
 )
 
 // Sequences
 
+const (
+	BinaryType     = seq.BinaryType
+	BytecodeType   = seq.BytecodeType
+	IdentifierType = seq.IdentifierType
+	NameType       = seq.NameType
+	NarrativeType  = seq.NarrativeType
+	PatternType    = seq.PatternType
+	QuoteType      = seq.QuoteType
+	SymbolType     = seq.SymbolType
+	TagType        = seq.TagType
+	VersionType    = seq.VersionType
+	MatrixType     = seq.MatrixType
+	VectorType     = seq.VectorType
+)
+
 type (
 	BinaryClassLike     = seq.BinaryClassLike
 	BytecodeClassLike   = seq.BytecodeClassLike
 	IdentifierClassLike = seq.IdentifierClassLike
+	MatrixClassLike     = seq.MatrixClassLike
 	NameClassLike       = seq.NameClassLike
 	NarrativeClassLike  = seq.NarrativeClassLike
 	PatternClassLike    = seq.PatternClassLike
 	QuoteClassLike      = seq.QuoteClassLike
+	ScannerClassLike    = seq.ScannerClassLike
 	SymbolClassLike     = seq.SymbolClassLike
 	TagClassLike        = seq.TagClassLike
+	VectorClassLike     = seq.VectorClassLike
 	VersionClassLike    = seq.VersionClassLike
 )
 
@@ -101,18 +209,20 @@ type (
 	BinaryLike     = seq.BinaryLike
 	BytecodeLike   = seq.BytecodeLike
 	IdentifierLike = seq.IdentifierLike
+	MatrixLike     = seq.MatrixLike
 	NameLike       = seq.NameLike
 	NarrativeLike  = seq.NarrativeLike
 	PatternLike    = seq.PatternLike
 	QuoteLike      = seq.QuoteLike
+	ScannerLike    = seq.ScannerLike
 	SymbolLike     = seq.SymbolLike
 	TagLike        = seq.TagLike
+	VectorLike     = seq.VectorLike
 	VersionLike    = seq.VersionLike
 )
 
 type (
 	Accessible[V any] = seq.Accessible[V]
-	Ordered[V any]    = seq.Ordered[V]
 	Searchable[V any] = seq.Searchable[V]
 	Sequential[V any] = seq.Sequential[V]
 )
@@ -141,6 +251,17 @@ func AngleFromSource(
 	)
 }
 
+func ParseAngle(
+	source string,
+) (
+	AngleLike,
+	error,
+) {
+	return AngleClass().ParseAngle(
+		source,
+	)
+}
+
 func BooleanClass() BooleanClassLike {
 	return ele.BooleanClass()
 }
@@ -161,18 +282,102 @@ func BooleanFromSource(
 	)
 }
 
+func ParseBoolean(
+	source string,
+) (
+	BooleanLike,
+	error,
+) {
+	return BooleanClass().ParseBoolean(
+		source,
+	)
+}
+
+func CborClass() CborClassLike {
+	return ele.CborClass()
+}
+
+func DecimalClass() DecimalClassLike {
+	return ele.DecimalClass()
+}
+
+func Decimal(
+	unscaled *big.Int,
+	scale uint,
+) DecimalLike {
+	return DecimalClass().Decimal(
+		unscaled,
+		scale,
+	)
+}
+
+func DecimalFromInteger(
+	integer int,
+) DecimalLike {
+	return DecimalClass().DecimalFromInteger(
+		integer,
+	)
+}
+
+func DecimalFromFloat(
+	float float64,
+	scale uint,
+) DecimalLike {
+	return DecimalClass().DecimalFromFloat(
+		float,
+		scale,
+	)
+}
+
+func DecimalFromSource(
+	source string,
+) DecimalLike {
+	return DecimalClass().DecimalFromSource(
+		source,
+	)
+}
+
+func ParseDecimal(
+	source string,
+) (
+	DecimalLike,
+	error,
+) {
+	return DecimalClass().ParseDecimal(
+		source,
+	)
+}
+
 func DurationClass() DurationClassLike {
 	return ele.DurationClass()
 }
 
 func Duration(
-	milliseconds uint,
+	milliseconds int,
 ) DurationLike {
 	return DurationClass().Duration(
 		milliseconds,
 	)
 }
 
+func DurationWithNanoseconds(
+	milliseconds int,
+	nanoseconds int,
+) DurationLike {
+	return DurationClass().DurationWithNanoseconds(
+		milliseconds,
+		nanoseconds,
+	)
+}
+
+func DurationFromTimeDuration(
+	duration tim.Duration,
+) DurationLike {
+	return DurationClass().DurationFromTimeDuration(
+		duration,
+	)
+}
+
 func DurationFromSource(
 	source string,
 ) DurationLike {
@@ -181,6 +386,21 @@ func DurationFromSource(
 	)
 }
 
+func ParseDuration(
+	source string,
+) (
+	DurationLike,
+	error,
+) {
+	return DurationClass().ParseDuration(
+		source,
+	)
+}
+
+func FormatClass() FormatClassLike {
+	return ele.FormatClass()
+}
+
 func GlyphClass() GlyphClassLike {
 	return ele.GlyphClass()
 }
@@ -209,6 +429,103 @@ func GlyphFromSource(
 	)
 }
 
+func ParseGlyph(
+	source string,
+) (
+	GlyphLike,
+	error,
+) {
+	return GlyphClass().ParseGlyph(
+		source,
+	)
+}
+
+func IntegerClass() IntegerClassLike {
+	return ele.IntegerClass()
+}
+
+func Integer(
+	integer int,
+) IntegerLike {
+	return IntegerClass().Integer(
+		integer,
+	)
+}
+
+func IntegerFromBigInt(
+	integer *big.Int,
+) IntegerLike {
+	return IntegerClass().IntegerFromBigInt(
+		integer,
+	)
+}
+
+func IntegerFromSource(
+	source string,
+) IntegerLike {
+	return IntegerClass().IntegerFromSource(
+		source,
+	)
+}
+
+func ParseInteger(
+	source string,
+) (
+	IntegerLike,
+	error,
+) {
+	return IntegerClass().ParseInteger(
+		source,
+	)
+}
+
+func IntervalClass() IntervalClassLike {
+	return ele.IntervalClass()
+}
+
+func Interval(
+	start MomentLike,
+	end MomentLike,
+) IntervalLike {
+	return IntervalClass().Interval(
+		start,
+		end,
+	)
+}
+
+func IntervalFromDuration(
+	start MomentLike,
+	duration DurationLike,
+) IntervalLike {
+	return IntervalClass().IntervalFromDuration(
+		start,
+		duration,
+	)
+}
+
+func IntervalFromSource(
+	source string,
+) IntervalLike {
+	return IntervalClass().IntervalFromSource(
+		source,
+	)
+}
+
+func ParseInterval(
+	source string,
+) (
+	IntervalLike,
+	error,
+) {
+	return IntervalClass().ParseInterval(
+		source,
+	)
+}
+
+func JsonClass() JsonClassLike {
+	return ele.JsonClass()
+}
+
 func MomentClass() MomentClassLike {
 	return ele.MomentClass()
 }
@@ -221,6 +538,24 @@ func Moment(
 	)
 }
 
+func MomentWithNanoseconds(
+	milliseconds int,
+	nanoseconds int,
+) MomentLike {
+	return MomentClass().MomentWithNanoseconds(
+		milliseconds,
+		nanoseconds,
+	)
+}
+
+func MomentFromTime(
+	time tim.Time,
+) MomentLike {
+	return MomentClass().MomentFromTime(
+		time,
+	)
+}
+
 func MomentFromSource(
 	source string,
 ) MomentLike {
@@ -229,6 +564,17 @@ func MomentFromSource(
 	)
 }
 
+func ParseMoment(
+	source string,
+) (
+	MomentLike,
+	error,
+) {
+	return MomentClass().ParseMoment(
+		source,
+	)
+}
+
 func NumberClass() NumberClassLike {
 	return ele.NumberClass()
 }
@@ -277,6 +623,22 @@ func NumberFromFloat(
 	)
 }
 
+func NumberFromBigFloat(
+	float *big.Float,
+) NumberLike {
+	return NumberClass().NumberFromBigFloat(
+		float,
+	)
+}
+
+func NumberFromBigRat(
+	rational *big.Rat,
+) NumberLike {
+	return NumberClass().NumberFromBigRat(
+		rational,
+	)
+}
+
 func NumberFromSource(
 	source string,
 ) NumberLike {
@@ -285,6 +647,49 @@ func NumberFromSource(
 	)
 }
 
+func ParseNumber(
+	source string,
+) (
+	NumberLike,
+	error,
+) {
+	return NumberClass().ParseNumber(
+		source,
+	)
+}
+
+func ParseErrorClass() ParseErrorClassLike {
+	return ele.ParseErrorClass()
+}
+
+func ParseError(
+	kind string,
+	source string,
+	offset uint,
+	expected string,
+) ParseErrorLike {
+	return ParseErrorClass().ParseError(
+		kind,
+		source,
+		offset,
+		expected,
+	)
+}
+
+func ParseErrorFromMatcher(
+	kind string,
+	matcher *reg.Regexp,
+	productions map[int]string,
+	source string,
+) ParseErrorLike {
+	return ParseErrorClass().ParseErrorFromMatcher(
+		kind,
+		matcher,
+		productions,
+		source,
+	)
+}
+
 func PercentageClass() PercentageClassLike {
 	return ele.PercentageClass()
 }
@@ -313,6 +718,17 @@ func PercentageFromSource(
 	)
 }
 
+func ParsePercentage(
+	source string,
+) (
+	PercentageLike,
+	error,
+) {
+	return PercentageClass().ParsePercentage(
+		source,
+	)
+}
+
 func ProbabilityClass() ProbabilityClassLike {
 	return ele.ProbabilityClass()
 }
@@ -341,6 +757,117 @@ func ProbabilityFromSource(
 	)
 }
 
+func ParseProbability(
+	source string,
+) (
+	ProbabilityLike,
+	error,
+) {
+	return ProbabilityClass().ParseProbability(
+		source,
+	)
+}
+
+func RationalClass() RationalClassLike {
+	return ele.RationalClass()
+}
+
+func Rational(
+	numerator int,
+	denominator int,
+) RationalLike {
+	return RationalClass().Rational(
+		numerator,
+		denominator,
+	)
+}
+
+func RationalFromBigRat(
+	rational *big.Rat,
+) RationalLike {
+	return RationalClass().RationalFromBigRat(
+		rational,
+	)
+}
+
+func RationalFromNumber(
+	number NumberLike,
+) RationalLike {
+	return RationalClass().RationalFromNumber(
+		number,
+	)
+}
+
+func RationalFromPercentage(
+	percentage PercentageLike,
+) RationalLike {
+	return RationalClass().RationalFromPercentage(
+		percentage,
+	)
+}
+
+func RationalFromProbability(
+	probability ProbabilityLike,
+) RationalLike {
+	return RationalClass().RationalFromProbability(
+		probability,
+	)
+}
+
+func RationalFromSource(
+	source string,
+) RationalLike {
+	return RationalClass().RationalFromSource(
+		source,
+	)
+}
+
+func ParseRational(
+	source string,
+) (
+	RationalLike,
+	error,
+) {
+	return RationalClass().ParseRational(
+		source,
+	)
+}
+
+func RecurrenceClass() RecurrenceClassLike {
+	return ele.RecurrenceClass()
+}
+
+func Recurrence(
+	start MomentLike,
+	duration DurationLike,
+	count uint,
+) RecurrenceLike {
+	return RecurrenceClass().Recurrence(
+		start,
+		duration,
+		count,
+	)
+}
+
+func RecurrenceFromSource(
+	source string,
+) RecurrenceLike {
+	return RecurrenceClass().RecurrenceFromSource(
+		source,
+	)
+}
+
+func ParseRecurrence(
+	source string,
+) (
+	RecurrenceLike,
+	error,
+) {
+	return RecurrenceClass().ParseRecurrence(
+		source,
+	)
+}
+
 func ResourceClass() ResourceClassLike {
 	return ele.ResourceClass()
 }
@@ -361,6 +888,17 @@ func ResourceFromSource(
 	)
 }
 
+func ParseResource(
+	source string,
+) (
+	ResourceLike,
+	error,
+) {
+	return ResourceClass().ParseResource(
+		source,
+	)
+}
+
 func ResourceFromUri(
 	url *uri.URL,
 ) ResourceLike {
@@ -399,6 +937,17 @@ func BinaryFromSource(
 	)
 }
 
+func ParseBinary(
+	source string,
+) (
+	BinaryLike,
+	error,
+) {
+	return BinaryClass().ParseBinary(
+		source,
+	)
+}
+
 func BytecodeClass() BytecodeClassLike {
 	return seq.BytecodeClass()
 }
@@ -427,6 +976,17 @@ func BytecodeFromSource(
 	)
 }
 
+func ParseBytecode(
+	source string,
+) (
+	BytecodeLike,
+	error,
+) {
+	return BytecodeClass().ParseBytecode(
+		source,
+	)
+}
+
 func IdentifierClass() IdentifierClassLike {
 	return seq.IdentifierClass()
 }
@@ -455,6 +1015,82 @@ func IdentifierFromSource(
 	)
 }
 
+func ParseIdentifier(
+	source string,
+) (
+	IdentifierLike,
+	error,
+) {
+	return IdentifierClass().ParseIdentifier(
+		source,
+	)
+}
+
+func MatrixClass() MatrixClassLike {
+	return seq.MatrixClass()
+}
+
+func Matrix(
+	values [][]complex128,
+) MatrixLike {
+	return MatrixClass().Matrix(
+		values,
+	)
+}
+
+func MatrixFromSequence(
+	sequence Sequential[VectorLike],
+) MatrixLike {
+	return MatrixClass().MatrixFromSequence(
+		sequence,
+	)
+}
+
+func Identity(
+	size uint,
+) MatrixLike {
+	return MatrixClass().Identity(
+		size,
+	)
+}
+
+func Rotation(
+	angle AngleLike,
+) MatrixLike {
+	return MatrixClass().Rotation(
+		angle,
+	)
+}
+
+func RotationAbout(
+	axis VectorLike,
+	angle AngleLike,
+) MatrixLike {
+	return MatrixClass().RotationAbout(
+		axis,
+		angle,
+	)
+}
+
+func MatrixFromSource(
+	source string,
+) MatrixLike {
+	return MatrixClass().MatrixFromSource(
+		source,
+	)
+}
+
+func ParseMatrix(
+	source string,
+) (
+	MatrixLike,
+	error,
+) {
+	return MatrixClass().ParseMatrix(
+		source,
+	)
+}
+
 func NameClass() NameClassLike {
 	return seq.NameClass()
 }
@@ -483,6 +1119,17 @@ func NameFromSource(
 	)
 }
 
+func ParseName(
+	source string,
+) (
+	NameLike,
+	error,
+) {
+	return NameClass().ParseName(
+		source,
+	)
+}
+
 func NarrativeClass() NarrativeClassLike {
 	return seq.NarrativeClass()
 }
@@ -511,6 +1158,17 @@ func NarrativeFromSource(
 	)
 }
 
+func ParseNarrative(
+	source string,
+) (
+	NarrativeLike,
+	error,
+) {
+	return NarrativeClass().ParseNarrative(
+		source,
+	)
+}
+
 func PatternClass() PatternClassLike {
 	return seq.PatternClass()
 }
@@ -539,6 +1197,17 @@ func PatternFromSource(
 	)
 }
 
+func ParsePattern(
+	source string,
+) (
+	PatternLike,
+	error,
+) {
+	return PatternClass().ParsePattern(
+		source,
+	)
+}
+
 func QuoteClass() QuoteClassLike {
 	return seq.QuoteClass()
 }
@@ -567,6 +1236,29 @@ func QuoteFromSource(
 	)
 }
 
+func ParseQuote(
+	source string,
+) (
+	QuoteLike,
+	error,
+) {
+	return QuoteClass().ParseQuote(
+		source,
+	)
+}
+
+func ScannerClass() ScannerClassLike {
+	return seq.ScannerClass()
+}
+
+func Scanner(
+	reader io.Reader,
+) ScannerLike {
+	return ScannerClass().Scanner(
+		reader,
+	)
+}
+
 func SymbolClass() SymbolClassLike {
 	return seq.SymbolClass()
 }
@@ -595,6 +1287,17 @@ func SymbolFromSource(
 	)
 }
 
+func ParseSymbol(
+	source string,
+) (
+	SymbolLike,
+	error,
+) {
+	return SymbolClass().ParseSymbol(
+		source,
+	)
+}
+
 func TagClass() TagClassLike {
 	return seq.TagClass()
 }
@@ -631,6 +1334,56 @@ func TagFromSource(
 	)
 }
 
+func ParseTag(
+	source string,
+) (
+	TagLike,
+	error,
+) {
+	return TagClass().ParseTag(
+		source,
+	)
+}
+
+func VectorClass() VectorClassLike {
+	return seq.VectorClass()
+}
+
+func Vector(
+	values []complex128,
+) VectorLike {
+	return VectorClass().Vector(
+		values,
+	)
+}
+
+func VectorFromSequence(
+	sequence Sequential[NumberLike],
+) VectorLike {
+	return VectorClass().VectorFromSequence(
+		sequence,
+	)
+}
+
+func VectorFromSource(
+	source string,
+) VectorLike {
+	return VectorClass().VectorFromSource(
+		source,
+	)
+}
+
+func ParseVector(
+	source string,
+) (
+	VectorLike,
+	error,
+) {
+	return VectorClass().ParseVector(
+		source,
+	)
+}
+
 func VersionClass() VersionClassLike {
 	return seq.VersionClass()
 }
@@ -651,6 +1404,14 @@ func VersionFromSequence(
 	)
 }
 
+func VersionFromSemver(
+	semver string,
+) VersionLike {
+	return VersionClass().VersionFromSemver(
+		semver,
+	)
+}
+
 func VersionFromSource(
 	source string,
 ) VersionLike {
@@ -659,4 +1420,612 @@ func VersionFromSource(
 	)
 }
 
+func ParseVersion(
+	source string,
+) (
+	VersionLike,
+	error,
+) {
+	return VersionClass().ParseVersion(
+		source,
+	)
+}
+
 // GLOBAL FUNCTIONS
+
+/*
+ParsePrimitive parses the specified source string into whichever primitive
+type its lexical form represents.  It scans the source string using the same
+rules as the Scanner, so the candidate that matches the most characters is
+parsed, and when several candidates match the same number of characters the
+first of them in the following order of precedence is parsed:
+
+	duration, angle, moment, interval, resource, tag, symbol, name, binary,
+	bytecode, glyph, narrative, quote, pattern, recurrence, boolean, version,
+	probability, percentage, decimal, rational, integer, number, identifier,
+	matrix, vector
+
+So "pi" is a number rather than an identifier.  The source string must be
+matched in its entirety with no surrounding whitespace or comments.  If no
+candidate matches, the parse error of the candidate that got furthest into the
+source string is returned, and if the matching candidate cannot parse its value
+(e.g. "<2024-02-30>" is not on the calendar) its parse error is returned.
+*/
+func ParsePrimitive(
+	source string,
+) (
+	primitive any,
+	err error,
+) {
+	var scanner = Scanner(sts.NewReader(source))
+	if !scanner.ScanToken() {
+		err = scanner.GetError()
+		if err == nil {
+			err = ParseError("primitive", source, 0, "a primitive")
+		}
+		return
+	}
+	switch {
+	case scanner.GetStart() > 0:
+		err = ParseError("primitive", source, 0, "a primitive")
+	case scanner.GetEnd() < uint(len(source)):
+		err = ParseError(
+			scanner.GetKind(),
+			source,
+			scanner.GetEnd(),
+			"the end of the source",
+		)
+	default:
+		primitive = scanner.GetPrimitive()
+	}
+	return
+}
+
+/*
+DecodePrimitive decodes the specified binary encoding of a primitive into a
+primitive of the class identified by the type byte in its header.
+*/
+func DecodePrimitive(
+	bytes []byte,
+) (
+	primitive any,
+	err error,
+) {
+	if len(bytes) < 2 {
+		err = fmt.Errorf("The binary encoding is missing its header: %x", bytes)
+		return
+	}
+	var type_ = Type(bytes[1])
+	for _, candidate := range primitiveCandidates_ {
+		if candidate.type_ != type_ {
+			continue
+		}
+		var pointer = ref.New(ref.TypeOf(candidate.example))
+		var unmarshaler = pointer.Interface().(enc.BinaryUnmarshaler)
+		err = unmarshaler.UnmarshalBinary(bytes)
+		if err == nil {
+			primitive = pointer.Elem().Interface()
+		}
+		return
+	}
+	err = fmt.Errorf("The binary encoding has an unknown type: %v", type_)
+	return
+}
+
+/*
+DecodeCbor decodes the specified CBOR data item into a primitive of the class
+whose CBOR mapping (see CborClassLike) matches the tag and type of the data
+item.
+*/
+func DecodeCbor(
+	bytes []byte,
+) (
+	primitive any,
+	err error,
+) {
+	_, _, err = CborClass().Decode(bytes)
+	if err != nil {
+		return
+	}
+	for _, kind := range gra.PrimitivePrecedence() {
+		var candidate = primitiveCandidates_[kind]
+		var pointer = ref.New(ref.TypeOf(candidate.example))
+		var unmarshaler = pointer.Interface().(cborUnmarshaler_)
+		if unmarshaler.UnmarshalCBOR(bytes) == nil {
+			primitive = pointer.Elem().Interface()
+			return
+		}
+	}
+	err = fmt.Errorf("The CBOR data item does not represent a primitive: %x", bytes)
+	return
+}
+
+/*
+Primitive is a wrapper for a field whose type is one of the primitive instance
+interfaces (e.g. MomentLike) or any.  Since the concrete primitive classes are
+not exported, the encoding packages cannot unmarshal into such a field
+directly.  Wrapping the field in a Primitive allows it to be marshaled using
+the canonical source form of the primitive and unmarshaled by parsing that
+source form back into a primitive of the field type.  An undefined value is
+marshaled as empty text and vice versa.
+
+A Primitive is marshaled as JSON using the source encoding, and an undefined
+value is marshaled as an empty string.  Use a NativePrimitive for a field that
+should be marshaled using the native encoding instead.
+
+A Primitive is marshaled in binary using the binary format of its primitive,
+and an undefined value is marshaled as no bytes at all.  It is marshaled as
+CBOR using the CBOR mapping of its primitive, and an undefined value is
+marshaled as the CBOR null value.
+*/
+type Primitive[T any] struct {
+	Value T
+}
+
+func (v Primitive[T]) MarshalText() (
+	text []byte,
+	err error,
+) {
+	var value any = v.Value
+	if value == nil {
+		return
+	}
+	var primitive, ok = value.(interface{ AsSource() string })
+	if !ok {
+		err = fmt.Errorf("The value is not a primitive: %v", value)
+		return
+	}
+	text = []byte(primitive.AsSource())
+	return
+}
+
+func (v *Primitive[T]) UnmarshalText(
+	text []byte,
+) error {
+	var source = string(text)
+	if len(source) == 0 {
+		var undefined T
+		v.Value = undefined
+		return nil
+	}
+	var value, err = parsePrimitiveAs[T](source)
+	if err != nil {
+		return err
+	}
+	v.Value = value
+	return nil
+}
+
+func (v Primitive[T]) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	var value any = v.Value
+	if value == nil {
+		return jsn.Marshal("")
+	}
+	return jsn.Marshal(value)
+}
+
+func (v *Primitive[T]) UnmarshalJSON(
+	bytes []byte,
+) error {
+	if string(bytes) == "null" {
+		var undefined T
+		v.Value = undefined
+		return nil
+	}
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(source))
+}
+
+func (v Primitive[T]) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	var value any = v.Value
+	if value == nil {
+		return
+	}
+	var marshaler, ok = value.(enc.BinaryMarshaler)
+	if !ok {
+		err = fmt.Errorf("The value is not a primitive: %v", value)
+		return
+	}
+	return marshaler.MarshalBinary()
+}
+
+func (v *Primitive[T]) UnmarshalBinary(
+	bytes []byte,
+) error {
+	if len(bytes) == 0 {
+		var undefined T
+		v.Value = undefined
+		return nil
+	}
+	var primitive, err = DecodePrimitive(bytes)
+	if err != nil {
+		return err
+	}
+	var value, ok = primitive.(T)
+	if !ok {
+		return fmt.Errorf(
+			"The binary encoding does not represent a %T primitive: %v",
+			value,
+			primitive,
+		)
+	}
+	v.Value = value
+	return nil
+}
+
+func (v Primitive[T]) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	var value any = v.Value
+	if value == nil {
+		return CborClass().Encode(0, nil)
+	}
+	var marshaler, ok = value.(cborMarshaler_)
+	if !ok {
+		err = fmt.Errorf("The value is not a primitive: %v", value)
+		return
+	}
+	return marshaler.MarshalCBOR()
+}
+
+func (v *Primitive[T]) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, item, err = CborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag == 0 && item == nil {
+		var undefined T
+		v.Value = undefined
+		return nil
+	}
+	var primitive any
+	primitive, err = DecodeCbor(bytes)
+	if err != nil {
+		return err
+	}
+	var value, ok = primitive.(T)
+	if !ok {
+		return fmt.Errorf(
+			"The CBOR data item does not represent a %T primitive: %v",
+			value,
+			primitive,
+		)
+	}
+	v.Value = value
+	return nil
+}
+
+/*
+NativePrimitive is a wrapper for a field whose type is one of the primitive
+instance interfaces (e.g. MomentLike) or any, that is marshaled as JSON using
+the native encoding (see JsonClassLike).  An undefined value is marshaled as
+null and vice versa.  It is marshaled as text, in binary and as CBOR exactly
+like a Primitive.
+
+Since a native JSON value may represent several types of primitive (e.g. the
+number 0.5 could be an angle, a probability or a number), a native JSON value
+is only unmarshaled into a field of type any when exactly one type of primitive
+accepts it.  Otherwise an error is returned and the field type must be narrowed
+to the intended primitive instance interface.
+*/
+type NativePrimitive[T any] struct {
+	Value T
+}
+
+func (v NativePrimitive[T]) MarshalText() (
+	text []byte,
+	err error,
+) {
+	return Primitive[T](v).MarshalText()
+}
+
+func (v *NativePrimitive[T]) UnmarshalText(
+	text []byte,
+) error {
+	return (*Primitive[T])(v).UnmarshalText(text)
+}
+
+func (v NativePrimitive[T]) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	var value any = v.Value
+	if value == nil {
+		return jsn.Marshal(nil)
+	}
+	return JsonClass().Marshal(value, NativeEncoding)
+}
+
+func (v *NativePrimitive[T]) UnmarshalJSON(
+	bytes []byte,
+) error {
+	if string(bytes) == "null" {
+		var undefined T
+		v.Value = undefined
+		return nil
+	}
+	var value, err = unmarshalPrimitiveAs[T](bytes)
+	if err != nil {
+		return err
+	}
+	v.Value = value
+	return nil
+}
+
+func (v NativePrimitive[T]) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	return Primitive[T](v).MarshalBinary()
+}
+
+func (v *NativePrimitive[T]) UnmarshalBinary(
+	bytes []byte,
+) error {
+	return (*Primitive[T])(v).UnmarshalBinary(bytes)
+}
+
+func (v NativePrimitive[T]) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	return Primitive[T](v).MarshalCBOR()
+}
+
+func (v *NativePrimitive[T]) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	return (*Primitive[T])(v).UnmarshalCBOR(bytes)
+}
+
+// Private Functions
+
+// This private function parses the specified source string into a primitive of
+// the specified type.  Unlike ParsePrimitive, the type resolves any ambiguity
+// (e.g. "pi" is an identifier when an IdentifierLike is expected).
+func parsePrimitiveAs[T any](
+	source string,
+) (
+	value T,
+	err error,
+) {
+	var primitive any
+	primitive, err = ParsePrimitive(source)
+	var ok bool
+	if err == nil {
+		value, ok = primitive.(T)
+		if ok {
+			return
+		}
+	}
+	for _, kind := range gra.PrimitivePrecedence() {
+		var candidate, failure = primitiveCandidates_[kind].parse(source)
+		if failure != nil {
+			continue
+		}
+		value, ok = candidate.(T)
+		if ok {
+			err = nil
+			return
+		}
+	}
+	if err == nil {
+		err = fmt.Errorf(
+			"The source string does not represent a %T primitive: %v",
+			value,
+			primitive,
+		)
+	}
+	return
+}
+
+// These private interfaces are implemented by every primitive class.  They
+// follow the naming convention used by the common Go CBOR packages.
+type cborMarshaler_ interface {
+	MarshalCBOR() ([]byte, error)
+}
+
+type cborUnmarshaler_ interface {
+	UnmarshalCBOR(bytes []byte) error
+}
+
+// This private function unmarshals the specified natively encoded JSON value
+// into a primitive of the specified type.  Since most native JSON values could
+// represent several different types of primitive, every primitive type that is
+// assignable to the specified type is tried and an error is returned unless
+// exactly one of them accepts the JSON value.
+func unmarshalPrimitiveAs[T any](
+	bytes []byte,
+) (
+	value T,
+	err error,
+) {
+	var target = ref.TypeFor[T]()
+	var kinds []string
+	for _, kind := range gra.PrimitivePrecedence() {
+		var concrete = ref.TypeOf(primitiveCandidates_[kind].example)
+		if !concrete.AssignableTo(target) {
+			continue
+		}
+		var pointer = ref.New(concrete)
+		var failure = JsonClass().Unmarshal(bytes, NativeEncoding, pointer.Interface())
+		if failure == nil {
+			value = pointer.Elem().Interface().(T)
+			kinds = append(kinds, kind)
+		}
+	}
+	switch len(kinds) {
+	case 0:
+		err = fmt.Errorf(
+			"The JSON value does not represent a %v primitive: %s",
+			target,
+			bytes,
+		)
+	case 1:
+	default:
+		var undefined T
+		value = undefined
+		err = fmt.Errorf(
+			"The JSON value is ambiguous, it could be any of (%s): %s",
+			sts.Join(kinds, ", "),
+			bytes,
+		)
+	}
+	return
+}
+
+type candidate_ struct {
+	parse   func(source string) (any, error)
+	example any
+	type_   Type
+}
+
+var primitiveCandidates_ = map[string]candidate_{
+	"angle": {
+		func(source string) (any, error) { return ParseAngle(source) },
+		AngleClass().Zero(),
+		AngleType,
+	},
+	"boolean": {
+		func(source string) (any, error) { return ParseBoolean(source) },
+		BooleanClass().Boolean(false),
+		BooleanType,
+	},
+	"decimal": {
+		func(source string) (any, error) { return ParseDecimal(source) },
+		DecimalClass().Zero(),
+		DecimalType,
+	},
+	"duration": {
+		func(source string) (any, error) { return ParseDuration(source) },
+		DurationClass().Duration(0),
+		DurationType,
+	},
+	"glyph": {
+		func(source string) (any, error) { return ParseGlyph(source) },
+		GlyphClass().Glyph('a'),
+		GlyphType,
+	},
+	"integer": {
+		func(source string) (any, error) { return ParseInteger(source) },
+		IntegerClass().Zero(),
+		IntegerType,
+	},
+	"interval": {
+		func(source string) (any, error) { return ParseInterval(source) },
+		IntervalClass().Interval(MomentClass().Moment(0), MomentClass().Moment(0)),
+		IntervalType,
+	},
+	"moment": {
+		func(source string) (any, error) { return ParseMoment(source) },
+		MomentClass().Moment(0),
+		MomentType,
+	},
+	"number": {
+		func(source string) (any, error) { return ParseNumber(source) },
+		NumberClass().Zero(),
+		NumberType,
+	},
+	"percentage": {
+		func(source string) (any, error) { return ParsePercentage(source) },
+		PercentageClass().Percentage(0),
+		PercentageType,
+	},
+	"probability": {
+		func(source string) (any, error) { return ParseProbability(source) },
+		ProbabilityClass().Probability(0),
+		ProbabilityType,
+	},
+	"rational": {
+		func(source string) (any, error) { return ParseRational(source) },
+		RationalClass().Zero(),
+		RationalType,
+	},
+	"recurrence": {
+		func(source string) (any, error) { return ParseRecurrence(source) },
+		RecurrenceClass().Recurrence(
+			MomentClass().Moment(0),
+			DurationClass().Duration(1),
+			1,
+		),
+		RecurrenceType,
+	},
+	"resource": {
+		func(source string) (any, error) { return ParseResource(source) },
+		ResourceClass().Undefined(),
+		ResourceType,
+	},
+	"binary": {
+		func(source string) (any, error) { return ParseBinary(source) },
+		BinaryClass().Binary(nil),
+		BinaryType,
+	},
+	"bytecode": {
+		func(source string) (any, error) { return ParseBytecode(source) },
+		BytecodeClass().Bytecode(nil),
+		BytecodeType,
+	},
+	"identifier": {
+		func(source string) (any, error) { return ParseIdentifier(source) },
+		IdentifierClass().Undefined(),
+		IdentifierType,
+	},
+	"matrix": {
+		func(source string) (any, error) { return ParseMatrix(source) },
+		MatrixClass().Identity(1),
+		MatrixType,
+	},
+	"name": {
+		func(source string) (any, error) { return ParseName(source) },
+		NameClass().Name(nil),
+		NameType,
+	},
+	"narrative": {
+		func(source string) (any, error) { return ParseNarrative(source) },
+		NarrativeClass().Narrative(nil),
+		NarrativeType,
+	},
+	"pattern": {
+		func(source string) (any, error) { return ParsePattern(source) },
+		PatternClass().Pattern([]rune(".*")),
+		PatternType,
+	},
+	"quote": {
+		func(source string) (any, error) { return ParseQuote(source) },
+		QuoteClass().Quote(nil),
+		QuoteType,
+	},
+	"symbol": {
+		func(source string) (any, error) { return ParseSymbol(source) },
+		SymbolClass().Undefined(),
+		SymbolType,
+	},
+	"tag": {
+		func(source string) (any, error) { return ParseTag(source) },
+		TagClass().Tag(make([]byte, 8)),
+		TagType,
+	},
+	"vector": {
+		func(source string) (any, error) { return ParseVector(source) },
+		VectorClass().Vector(nil),
+		VectorType,
+	},
+	"version": {
+		func(source string) (any, error) { return ParseVersion(source) },
+		VersionClass().Version([]uint{1}),
+		VersionType,
+	},
+}
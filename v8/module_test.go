@@ -13,11 +13,26 @@
 package module_test
 
 import (
+	byt "bytes"
+	ctx "context"
+	sql "database/sql"
+	drv "database/sql/driver"
+	enc "encoding"
+	gob "encoding/gob"
+	jsn "encoding/json"
 	pri "github.com/craterdog/go-essential-primitives/v8"
 	ass "github.com/stretchr/testify/assert"
+	io "io"
 	mat "math"
+	big "math/big"
 	cmp "math/cmplx"
+	uri "net/url"
+	ref "reflect"
+	sli "slices"
+	sts "strings"
 	tes "testing"
+	iot "testing/iotest"
+	tim "time"
 )
 
 // ELEMENT
@@ -199,7 +214,7 @@ var one uint = 1
 func TestZeroDurations(t *tes.T) {
 	var v = pri.Duration(0)
 	ass.Equal(t, 0, v.AsInteger())
-	ass.Equal(t, zero, v.AsIntrinsic())
+	ass.Equal(t, 0, v.AsIntrinsic())
 	ass.Equal(t, 0.0, v.AsMilliseconds())
 	ass.Equal(t, 0.0, v.AsSeconds())
 	ass.Equal(t, 0.0, v.AsMinutes())
@@ -225,11 +240,51 @@ func TestStringDurations(t *tes.T) {
 	ass.Equal(t, "~P0W", duration.AsSource())
 }
 
+func TestNegativeDurations(t *tes.T) {
+	var v = pri.DurationFromSource("~-P1DT2H")
+	ass.Equal(t, "~-P1DT2H", v.AsSource())
+	ass.Equal(t, -93600000, v.AsIntrinsic())
+	ass.True(t, v.IsNegative())
+	ass.Equal(t, -26.0, v.AsHours())
+	ass.Equal(t, one, v.GetDays())
+	ass.Equal(t, uint(2), v.GetHours())
+	ass.Equal(t, "~-P2W", pri.Duration(-1209600000).AsSource())
+	ass.Equal(t, "~P0W", pri.DurationFromSource("~-P0W").AsSource())
+	ass.False(t, pri.Duration(0).IsNegative())
+
+	var earlier = pri.MomentFromSource("<2024-01-02>")
+	var later = pri.MomentFromSource("<2024-01-05>")
+	var moments = pri.MomentClass()
+	ass.Equal(t, "~P3D", moments.Duration(later, earlier).AsSource())
+	ass.Equal(t, "~-P3D", moments.Offset(later, earlier).AsSource())
+	ass.Equal(t, "~P3D", moments.Offset(earlier, later).AsSource())
+	ass.Equal(t, earlier, moments.Later(later, moments.Offset(later, earlier)))
+}
+
+func TestDurationAlgebra(t *tes.T) {
+	var class = pri.DurationClass()
+	var day = pri.DurationFromSource("~P1D")
+	var hour = pri.DurationFromSource("~PT1H")
+	ass.Equal(t, "~P1DT1H", class.Sum(day, hour).AsSource())
+	ass.Equal(t, "~PT23H", class.Difference(day, hour).AsSource())
+	ass.Equal(t, "~-PT23H", class.Difference(hour, day).AsSource())
+	ass.Equal(t, "~PT12H", class.Scaled(day, 0.5).AsSource())
+	ass.Equal(t, "~-P1W", class.Scaled(day, -7).AsSource())
+	ass.Equal(t, 24.0, class.Quotient(day, hour))
+	ass.Equal(t, -1.0, class.Quotient(class.Scaled(hour, -1), hour))
+	ass.Equal(t, hour, class.Minimum(day, hour))
+	ass.Equal(t, day, class.Maximum(day, hour))
+	ass.Equal(t, 1, class.Compare(day, hour))
+	ass.Equal(t, -1, class.Compare(hour, day))
+	ass.Equal(t, 0, class.Compare(day, class.Scaled(hour, 24)))
+	ass.Equal(t, -1, class.Compare(class.Scaled(day, -1), hour))
+}
+
 func TestDurations(t *tes.T) {
 	var v = pri.Duration(60000)
 	ass.Equal(t, "~PT1M", v.AsSource())
 	ass.Equal(t, 60000, v.AsInteger())
-	ass.Equal(t, uint(60000), v.AsIntrinsic())
+	ass.Equal(t, 60000, v.AsIntrinsic())
 	ass.Equal(t, 60000.0, v.AsMilliseconds())
 	ass.Equal(t, 60.0, v.AsSeconds())
 	ass.Equal(t, 1.0, v.AsMinutes())
@@ -348,6 +403,432 @@ func TestMomentsLibrary(t *tes.T) {
 	ass.Equal(t, before, class.Earlier(after, duration))
 }
 
+func TestMomentZones(t *tes.T) {
+	var zoned = map[string]string{
+		"<2024-01-02T03:04Z>":               "<2024-01-02T03:04>",
+		"<2024-01-02T03:04+05:30>":          "<2024-01-01T21:34>",
+		"<2024-01-02T03-08>":                "<2024-01-02T11>",
+		"<2024-07-01T12[America/New_York]>": "<2024-07-01T16>",
+		"<2024-01-01T12[America/New_York]>": "<2024-01-01T17>",
+		"<2024-01-02[Europe/Paris]>":        "<2024-01-01T23>",
+		"<-100-01-02T03:04+05:30>":          "<-100-01-01T21:34>",
+	}
+	for source, expected := range zoned {
+		var moment, err = pri.ParseMoment(source)
+		ass.Nil(t, err)
+		ass.Equal(t, expected, moment.AsSource())
+	}
+	var _, err = pri.ParseMoment("<2024-01-02[Nowhere/Land]>")
+	ass.NotNil(t, err)
+	ass.Equal(t, "a known time zone", err.(pri.ParseErrorLike).GetExpected())
+	ass.Equal(t, uint(11), err.(pri.ParseErrorLike).GetOffset())
+	_, err = pri.ParseMoment("<2024-01-02[Local]>")
+	ass.NotNil(t, err)
+	_, err = pri.ParseMoment("<2024-01-02T03+24:00>")
+	ass.NotNil(t, err)
+
+	var class = pri.MomentClass()
+	var moment = pri.MomentFromSource("<2024-12-31T23:30:15.250>")
+	var factors pri.Factored
+	factors, err = class.FactorsInZone(moment, "Asia/Kolkata")
+	ass.Nil(t, err)
+	ass.Equal(t, uint(2025), factors.GetYears())
+	ass.Equal(t, uint(1), factors.GetMonths())
+	ass.Equal(t, uint(1), factors.GetDays())
+	ass.Equal(t, uint(5), factors.GetHours())
+	ass.Equal(t, uint(0), factors.GetMinutes())
+	ass.Equal(t, uint(15), factors.GetSeconds())
+	ass.Equal(t, uint(250), factors.GetMilliseconds())
+	ass.Equal(t, uint(1), factors.GetWeeks())
+	factors, err = class.FactorsInZone(moment, "-08:00")
+	ass.Nil(t, err)
+	ass.Equal(t, uint(15), factors.GetHours())
+	factors, err = class.FactorsInZone(moment, "Z")
+	ass.Nil(t, err)
+	ass.Equal(t, moment.GetHours(), factors.GetHours())
+	_, err = class.FactorsInZone(moment, "+5")
+	ass.NotNil(t, err)
+	_, err = class.FactorsInZone(moment, "Mars/Olympus_Mons")
+	ass.NotNil(t, err)
+}
+
+func TestMomentCalendar(t *tes.T) {
+	var class = pri.MomentClass()
+	var moment = pri.MomentFromSource("<2024-01-31T10:30>")
+	ass.Equal(t, "<2024-02-29T10:30>", class.AddMonths(moment, 1).AsSource())
+	ass.Equal(t, "<2024-03-31T10:30>", class.AddMonths(moment, 2).AsSource())
+	ass.Equal(t, "<2023-11-30T10:30>", class.AddMonths(moment, -2).AsSource())
+	ass.Equal(t, "<2025-02-28T10:30>", class.AddMonths(moment, 13).AsSource())
+	ass.Equal(t, "<2023-01-31T10:30>", class.AddYears(moment, -1).AsSource())
+	ass.Equal(t, "<2024-02-14T10:30>", class.AddWeeks(moment, 2).AsSource())
+	ass.Equal(t, "<2024-03-01T10:30>", class.AddDays(moment, 30).AsSource())
+	var leap = pri.MomentFromSource("<2024-02-29>")
+	ass.Equal(t, "<2025-02-28>", class.AddYears(leap, 1).AsSource())
+	ass.Equal(t, "<2028-02-29>", class.AddYears(leap, 4).AsSource())
+	var ancient = pri.MomentFromSource("<-10000-03-31>")
+	ass.Equal(t, "<-10000-04-30>", class.AddMonths(ancient, 1).AsSource())
+
+	moment = pri.MomentFromSource("<2024-05-16T13:45:30.500>")
+	ass.Equal(t, "<2024-05-16>", class.Truncated(moment, pri.DayPeriod).AsSource())
+	ass.Equal(t, "<2024-05-13>", class.Truncated(moment, pri.WeekPeriod).AsSource())
+	ass.Equal(t, "<2024-05-01>", class.Truncated(moment, pri.MonthPeriod).AsSource())
+	ass.Equal(t, "<2024-04-01>", class.Truncated(moment, pri.QuarterPeriod).AsSource())
+	ass.Equal(t, "<2024-01-01>", class.Truncated(moment, pri.YearPeriod).AsSource())
+	ass.Equal(t, "<2024-05-17>", class.Rounded(moment, pri.DayPeriod).AsSource())
+	ass.Equal(t, "<2024-05-20>", class.Rounded(moment, pri.WeekPeriod).AsSource())
+	ass.Equal(t, "<2024-06-01>", class.Rounded(moment, pri.MonthPeriod).AsSource())
+	ass.Equal(t, "<2024-07-01>", class.Rounded(moment, pri.QuarterPeriod).AsSource())
+	ass.Equal(t, "<2024-01-01>", class.Rounded(moment, pri.YearPeriod).AsSource())
+	var sunday = pri.MomentFromSource("<2024-05-19T23>")
+	ass.Equal(t, "<2024-05-13>", class.Truncated(sunday, pri.WeekPeriod).AsSource())
+	var noon = pri.MomentFromSource("<2024-05-16T12>")
+	ass.Equal(t, "<2024-05-17>", class.Rounded(noon, pri.DayPeriod).AsSource())
+	ass.Equal(t, "QuarterPeriod", pri.QuarterPeriod.String())
+	ass.Panics(t, func() { class.Truncated(moment, pri.Period(42)) })
+}
+
+func TestWeekAndOrdinalDates(t *tes.T) {
+	var moment = pri.MomentFromSource("<2024-W05-3>")
+	ass.Equal(t, "<2024-01-31>", moment.AsSource())
+	ass.Equal(t, "<2024-W05-3>", moment.AsWeekDate())
+	ass.Equal(t, "<2024-031>", moment.AsOrdinalDate())
+	ass.Equal(t, 2024, moment.GetWeekYear())
+	ass.Equal(t, uint(3), moment.GetWeekday())
+	ass.Equal(t, uint(31), moment.GetDayOfYear())
+	ass.Equal(t, moment, pri.MomentFromSource("<2024-031>"))
+
+	moment = pri.MomentFromSource("<2024-366T23:59:30.5Z>")
+	ass.Equal(t, "<2024-12-31T23:59:30.500>", moment.AsSource())
+	ass.Equal(t, "<2025-W01-2T23:59:30.500>", moment.AsWeekDate())
+	ass.Equal(t, 2025, moment.GetWeekYear())
+	ass.Equal(t, uint(366), moment.GetDayOfYear())
+
+	moment = pri.MomentFromSource("<2020-W53-7T12[Europe/Paris]>")
+	ass.Equal(t, "<2021-01-03T11>", moment.AsSource())
+	ass.Equal(t, "<2020-W53-7T11>", moment.AsWeekDate())
+	ass.Equal(t, "<2021-003T11>", moment.AsOrdinalDate())
+	ass.Equal(t, 2020, moment.GetWeekYear())
+	ass.Equal(t, uint(7), moment.GetWeekday())
+
+	moment = pri.MomentFromSource("<-44-075>")
+	ass.Equal(t, "<-44-03-15>", moment.AsSource())
+	ass.Equal(t, "<-44-075>", moment.AsOrdinalDate())
+	ass.Equal(t, moment, pri.MomentFromSource(moment.AsWeekDate()))
+
+	var _, err = pri.ParseMoment("<2023-366>")
+	ass.NotNil(t, err)
+	_, err = pri.ParseMoment("<2021-W53-1>")
+	ass.NotNil(t, err)
+	_, err = pri.ParseMoment("<2024-W54-1>")
+	var parseError, ok = err.(pri.ParseErrorLike)
+	ass.True(t, ok)
+	ass.Equal(t, "week 01-53", parseError.GetExpected())
+	_, err = pri.ParseMoment("<2024-367>")
+	parseError, ok = err.(pri.ParseErrorLike)
+	ass.True(t, ok)
+	ass.Equal(t, uint(8), parseError.GetOffset())
+	ass.Equal(t, "day of year 001-366", parseError.GetExpected())
+}
+
+func TestSubmillisecondPrecision(t *tes.T) {
+	var duration = pri.DurationFromSource("~PT0.5S")
+	ass.Equal(t, "~PT0.500S", duration.AsSource())
+	ass.Equal(t, 500, duration.AsIntrinsic())
+	ass.Equal(t, "~PT1.005S", pri.Duration(1005).AsSource())
+	duration = pri.DurationFromSource("~PT0.000250S")
+	ass.Equal(t, "~PT0.000250S", duration.AsSource())
+	ass.Equal(t, 0, duration.AsIntrinsic())
+	ass.Equal(t, uint(250), duration.GetMicroseconds())
+	ass.Equal(t, uint(0), duration.GetNanoseconds())
+	ass.Equal(t, 0.25, duration.AsMilliseconds())
+	duration = pri.DurationFromSource("~-PT1.000000001S")
+	ass.Equal(t, "~-PT1.000000001S", duration.AsSource())
+	ass.Equal(t, -1000, duration.AsIntrinsic())
+	ass.True(t, duration.IsNegative())
+	ass.Equal(t, uint(1), duration.GetNanoseconds())
+	ass.Equal(t, pri.DurationFromSource("~PT0.001000500S"), pri.DurationWithNanoseconds(1, 500))
+	ass.Panics(t, func() { pri.DurationWithNanoseconds(1, -500) })
+	ass.Panics(t, func() { pri.DurationWithNanoseconds(0, 1000000) })
+
+	var class = pri.DurationClass()
+	var half = pri.DurationFromSource("~PT0.0005S")
+	ass.Equal(t, pri.Duration(1), class.Sum(half, half))
+	ass.Equal(t, "~PT0.000999S", class.Difference(pri.Duration(1), pri.DurationFromSource("~PT0.000001S")).AsSource())
+	ass.Equal(t, "~-PT0.000500S", class.Difference(half, pri.Duration(1)).AsSource())
+	ass.Equal(t, "~PT0.001500S", class.Scaled(half, 3).AsSource())
+	ass.Equal(t, 0.5, class.Quotient(half, pri.Duration(1)))
+	ass.Equal(t, -1, class.Compare(half, pri.Duration(1)))
+	ass.Equal(t, 1, class.Compare(pri.DurationFromSource("~-PT0.0005S"), pri.Duration(-1)))
+
+	var moment = pri.MomentFromSource("<2024-05-01T12:00:00.123456789>")
+	ass.Equal(t, "<2024-05-01T12:00:00.123456789>", moment.AsSource())
+	var truncated = pri.MomentFromSource("<2024-05-01T12:00:00.123>")
+	ass.Equal(t, truncated.AsIntrinsic(), moment.AsIntrinsic())
+	ass.Equal(t, uint(123), moment.GetMilliseconds())
+	ass.Equal(t, uint(456), moment.GetMicroseconds())
+	ass.Equal(t, uint(789), moment.GetNanoseconds())
+	ass.Equal(t, moment, pri.MomentWithNanoseconds(truncated.AsIntrinsic(), 456789))
+	ass.Panics(t, func() { pri.MomentWithNanoseconds(0, 1000000) })
+	ass.Panics(t, func() { pri.MomentWithNanoseconds(0, -1) })
+	var _, err = pri.ParseMoment("<2024-05-01T12:00:00.1234567891>")
+	ass.NotNil(t, err)
+	ass.Equal(
+		t,
+		"<2024-05-01T12:00:00.123457>",
+		pri.MomentClass().Later(moment, pri.DurationFromSource("~PT0.000000211S")).AsSource(),
+	)
+	ass.Equal(
+		t,
+		"~PT0.000456789S",
+		pri.MomentClass().Offset(truncated, moment).AsSource(),
+	)
+	ass.Equal(
+		t,
+		"~-PT0.000456789S",
+		pri.MomentClass().Offset(moment, truncated).AsSource(),
+	)
+	var before = pri.MomentFromSource("<1969-12-31T23:59:59.9999995>")
+	ass.Equal(t, -1, before.AsIntrinsic())
+	ass.Equal(t, "<1969-12-31T23:59:59.999999500>", before.AsSource())
+	ass.Equal(t, "~PT0.000000500S", pri.MomentClass().Duration(pri.MomentClass().Epoch(), before).AsSource())
+	var factors, _ = pri.MomentClass().FactorsInZone(moment, "+05:30")
+	ass.Equal(t, uint(456), factors.GetMicroseconds())
+	ass.Equal(t, uint(789), factors.GetNanoseconds())
+}
+
+func TestMillisecondSourceForms(t *tes.T) {
+	// These millisecond durations and moments render as they did before the
+	// nanosecond precision was added.
+	var durations = map[int]string{
+		0:           "~P0W",
+		500:         "~PT0.500S",
+		999:         "~PT0.999S",
+		1000:        "~PT1S",
+		1500:        "~PT1.500S",
+		-60000:      "~-PT1M",
+		86400000:    "~P1D",
+		604800000:   "~P1W",
+		31556952000: "~P1Y",
+	}
+	for milliseconds, source := range durations {
+		var duration = pri.Duration(milliseconds)
+		ass.Equal(t, source, duration.AsSource())
+		ass.Equal(t, duration, pri.DurationFromSource(source))
+		ass.Equal(t, milliseconds, duration.AsIntrinsic())
+	}
+	var moments = map[int]string{
+		0:             "<1970-01-01>",
+		1:             "<1970-01-01T00:00:00.001>",
+		50:            "<1970-01-01T00:00:00.050>",
+		-1:            "<1969-12-31T23:59:59.999>",
+		1704164645006: "<2024-01-02T03:04:05.006>",
+		1704164645600: "<2024-01-02T03:04:05.600>",
+		1704164640000: "<2024-01-02T03:04>",
+		1704153600000: "<2024-01-02>",
+	}
+	for milliseconds, source := range moments {
+		var moment = pri.Moment(milliseconds)
+		ass.Equal(t, source, moment.AsSource())
+		ass.Equal(t, moment, pri.MomentFromSource(source))
+		ass.Equal(t, milliseconds, moment.AsIntrinsic())
+	}
+
+	// A duration whose milliseconds are below one hundred used to drop the
+	// leading zeros of its fraction, so ~PT1.5S could not be told apart from
+	// 1500 milliseconds.  The fraction is now zero-padded.
+	ass.Equal(t, "~PT1.005S", pri.Duration(1005).AsSource())
+	ass.Equal(t, "~PT0.050S", pri.Duration(50).AsSource())
+	ass.Equal(t, "~-PT0.001S", pri.Duration(-1).AsSource())
+	ass.Equal(t, 1050, pri.DurationFromSource("~PT1.05S").AsIntrinsic())
+
+	// Millisecond durations and moments compare as their intrinsic values do.
+	var class = pri.DurationClass()
+	ass.Equal(t, -1, class.Compare(pri.Duration(999), pri.Duration(1000)))
+	ass.Equal(t, 0, class.Compare(pri.Duration(-5), pri.DurationFromSource("~-PT0.005S")))
+	ass.True(t, pri.Moment(1).IsBefore(pri.Moment(2)))
+	ass.False(t, pri.Moment(2).IsBefore(pri.Moment(2)))
+}
+
+func TestIntervals(t *tes.T) {
+	var class = pri.IntervalClass()
+	var january = pri.IntervalFromSource("<2024-01-01>..<2024-02-01>")
+	ass.Equal(t, "<2024-01-01>..<2024-02-01>", january.AsSource())
+	ass.Equal(t, 31.0, january.GetDuration().AsDays())
+	ass.Equal(t, pri.MomentFromSource("<2024-01-01>"), january.GetStart())
+	ass.Equal(t, pri.MomentFromSource("<2024-02-01>"), january.GetEnd())
+	ass.False(t, january.IsEmpty())
+	var week = pri.IntervalFromSource("<2024-01-29>/~P1W")
+	ass.Equal(t, "<2024-01-29>..<2024-02-05>", week.AsSource())
+	ass.Equal(t, week, pri.IntervalFromDuration(
+		pri.MomentFromSource("<2024-01-29>"),
+		pri.DurationFromSource("~P1W"),
+	))
+	var february = pri.Interval(
+		pri.MomentFromSource("<2024-02-01>"),
+		pri.MomentFromSource("<2024-03-01>"),
+	)
+
+	ass.True(t, class.Contains(january, pri.MomentFromSource("<2024-01-01>")))
+	ass.True(t, class.Contains(january, pri.MomentFromSource("<2024-01-31T23>")))
+	ass.False(t, class.Contains(january, pri.MomentFromSource("<2024-02-01>")))
+	ass.True(t, class.Overlaps(january, week))
+	ass.False(t, class.Overlaps(january, february))
+	var result, ok = class.Intersection(january, week)
+	ass.True(t, ok)
+	ass.Equal(t, "<2024-01-29>..<2024-02-01>", result.AsSource())
+	result, ok = class.Intersection(january, february)
+	ass.False(t, ok)
+	ass.Nil(t, result)
+	result, ok = class.Union(january, february)
+	ass.True(t, ok)
+	ass.Equal(t, "<2024-01-01>..<2024-03-01>", result.AsSource())
+	result, ok = class.Union(week, january)
+	ass.True(t, ok)
+	ass.Equal(t, "<2024-01-01>..<2024-02-05>", result.AsSource())
+	var march = pri.IntervalFromSource("<2024-03-10>/~P1D")
+	result, ok = class.Union(january, march)
+	ass.False(t, ok)
+	ass.Nil(t, result)
+	result, ok = class.Gap(march, january)
+	ass.True(t, ok)
+	ass.Equal(t, "<2024-02-01>..<2024-03-10>", result.AsSource())
+	result, ok = class.Gap(january, february)
+	ass.False(t, ok)
+	ass.Nil(t, result)
+	result, ok = class.Gap(january, week)
+	ass.False(t, ok)
+	ass.Nil(t, result)
+
+	var parts = class.Split(january, pri.DurationFromSource("~P10D"))
+	ass.Equal(t, 4, len(parts))
+	ass.Equal(t, "<2024-01-31>..<2024-02-01>", parts[3].AsSource())
+	var iterator = class.Subintervals(week, pri.DurationFromSource("~P1D"))
+	var count int
+	for iterator.HasNext() {
+		var day = iterator.GetNext()
+		ass.Equal(t, "~P1D", day.GetDuration().AsSource())
+		count++
+	}
+	ass.Equal(t, 7, count)
+	ass.Equal(t, "<2024-02-04>..<2024-02-05>", iterator.GetPrevious().AsSource())
+	iterator = class.Subintervals(january, pri.DurationFromSource("~PT0.0015S"))
+	ass.Equal(t, 1785600000, int(iterator.GetSize()))
+	ass.Equal(t, 1, iterator.GetNext().GetDuration().AsIntrinsic())
+	ass.Equal(t, 2, iterator.GetNext().GetDuration().AsIntrinsic())
+	iterator.ToEnd()
+	ass.Equal(t, "<2024-01-31T23:59:59.998>..<2024-02-01>", iterator.GetPrevious().AsSource())
+	ass.Panics(t, func() { class.Split(january, pri.Duration(0)) })
+	ass.Panics(t, func() {
+		class.Subintervals(january, pri.DurationFromSource("~PT0.0005S"))
+	})
+	ass.Panics(t, func() { pri.Interval(january.GetEnd(), january.GetStart()) })
+
+	var empty = pri.IntervalFromSource("<2024-01-01>..<2024-01-01>")
+	ass.True(t, empty.IsEmpty())
+	ass.Nil(t, class.Split(empty, pri.DurationFromSource("~P1D")))
+	var _, err = pri.ParseInterval("<2024-02-01>..<2024-01-01>")
+	ass.NotNil(t, err)
+	_, err = pri.ParseInterval("<2024-01-01>/~-P1D")
+	ass.NotNil(t, err)
+	_, err = pri.ParseInterval("<2024-01-01>..<2024-13-01>")
+	ass.Equal(t, "end moment", err.(pri.ParseErrorLike).GetExpected())
+	var primitive any
+	primitive, err = pri.ParsePrimitive("<2024-01-01>/~P1D")
+	ass.Nil(t, err)
+	ass.Equal(t, "<2024-01-01>..<2024-01-02>", primitive.(pri.IntervalLike).AsSource())
+}
+
+func TestRecurrences(t *tes.T) {
+	var class = pri.RecurrenceClass()
+	var weekly = pri.RecurrenceFromSource("R3/<2024-01-01T09>/~P1W")
+	ass.Equal(t, "R3/<2024-01-01T09>/~P1W", weekly.AsSource())
+	ass.Equal(t, pri.MomentFromSource("<2024-01-01T09>"), weekly.GetStart())
+	ass.True(t, weekly.IsBounded())
+	ass.Equal(t, weekly, pri.Recurrence(
+		pri.MomentFromSource("<2024-01-01T09>"),
+		pri.DurationFromSource("~P1W"),
+		3,
+	))
+	var iterator = weekly.GetIterator()
+	ass.Equal(t, 3, int(iterator.GetSize()))
+	ass.Equal(t, "<2024-01-01T09>", iterator.GetNext().AsSource())
+	ass.Equal(t, "<2024-01-08T09>", iterator.GetNext().AsSource())
+	ass.Equal(t, "<2024-01-15T09>", iterator.GetNext().AsSource())
+	ass.False(t, iterator.HasNext())
+
+	var daily = pri.RecurrenceFromSource("R/<2024-01-01T09>/~P1D")
+	ass.False(t, daily.IsBounded())
+	ass.Panics(t, func() { daily.GetIterator() })
+	var window = pri.IntervalFromSource("<2024-01-03>/~P1W")
+	iterator = class.Occurrences(daily, window)
+	ass.Equal(t, 7, int(iterator.GetSize()))
+	ass.Equal(t, "<2024-01-03T09>", iterator.GetNext().AsSource())
+	iterator.ToEnd()
+	ass.Equal(t, "<2024-01-09T09>", iterator.GetPrevious().AsSource())
+	var decades = pri.IntervalFromSource("<2024-01-01>..<2124-01-01>")
+	iterator = class.Occurrences(pri.RecurrenceFromSource("R/<1970-01-01>/~PT1S"), decades)
+	ass.Equal(t, "<2024-01-01>", iterator.GetNext().AsSource())
+	ass.Equal(t, "<2024-01-01T00:00:01>", iterator.GetNext().AsSource())
+	ass.Equal(t, daily, pri.RecurrenceFromSource(daily.AsSource()))
+	ass.True(t, daily == pri.RecurrenceFromSource(daily.AsSource()))
+	ass.Panics(t, func() {
+		pri.Recurrence(daily.GetStart(), pri.Duration(0), 1)
+	})
+
+	var rule = pri.RecurrenceFromSource(
+		"R/<2024-01-01T09>/FREQ=WEEKLY;BYDAY=WE,MO;COUNT=4",
+	)
+	ass.Equal(t, "R/<2024-01-01T09>/FREQ=WEEKLY;COUNT=4;BYDAY=MO,WE", rule.AsSource())
+	ass.True(t, rule.IsBounded())
+	iterator = rule.GetIterator()
+	ass.Equal(t, 4, int(iterator.GetSize()))
+	iterator.ToEnd()
+	ass.Equal(t, "<2024-01-10T09>", iterator.GetPrevious().AsSource())
+	ass.Equal(t, "<2024-01-08T09>", iterator.GetPrevious().AsSource())
+
+	rule = pri.RecurrenceFromSource("R/<2024-01-31>/FREQ=MONTHLY;COUNT=3")
+	iterator = rule.GetIterator()
+	ass.Equal(t, "<2024-01-31>", iterator.GetNext().AsSource())
+	ass.Equal(t, "<2024-03-31>", iterator.GetNext().AsSource())
+	ass.Equal(t, "<2024-05-31>", iterator.GetNext().AsSource())
+	rule = pri.RecurrenceFromSource("R/<2024-01-31>/FREQ=MONTHLY;COUNT=3;BYMONTHDAY=-1")
+	iterator = rule.GetIterator()
+	ass.Equal(t, "<2024-01-31>", iterator.GetNext().AsSource())
+	ass.Equal(t, "<2024-02-29>", iterator.GetNext().AsSource())
+	ass.Equal(t, "<2024-03-31>", iterator.GetNext().AsSource())
+	rule = pri.RecurrenceFromSource("R/<2024-01-01>/FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13")
+	ass.False(t, rule.IsBounded())
+	iterator = class.Occurrences(rule, pri.IntervalFromSource("<2024-01-01>..<2025-01-01>"))
+	ass.Equal(t, 2, int(iterator.GetSize()))
+	ass.Equal(t, "<2024-09-13>", iterator.GetNext().AsSource())
+	ass.Equal(t, "<2024-12-13>", iterator.GetNext().AsSource())
+	rule = pri.RecurrenceFromSource("R/<2024-02-29>/FREQ=YEARLY")
+	iterator = class.Occurrences(rule, pri.IntervalFromSource("<2024-01-01>..<2034-01-01>"))
+	ass.Equal(t, 3, int(iterator.GetSize()))
+	rule = pri.RecurrenceFromSource("R/<2024-01-01>/FREQ=DAILY;UNTIL=20240107;INTERVAL=2")
+	ass.Equal(t, "R/<2024-01-01>/FREQ=DAILY;INTERVAL=2;UNTIL=20240107T000000Z", rule.AsSource())
+	ass.Equal(t, 4, int(rule.GetIterator().GetSize()))
+
+	var _, err = pri.ParseRecurrence("R5/<2024-01-01>/FREQ=DAILY")
+	ass.NotNil(t, err)
+	_, err = pri.ParseRecurrence("R/<2024-01-01>/FREQ=DAILY;COUNT=2;UNTIL=20240201")
+	ass.NotNil(t, err)
+	_, err = pri.ParseRecurrence("R/<2024-01-01>/FREQ=DAILY;BYMONTHDAY=32")
+	ass.NotNil(t, err)
+	_, err = pri.ParseRecurrence("R/<2024-01-01>/~-P1D")
+	ass.NotNil(t, err)
+	_, err = pri.ParseRecurrence("R/<2024-01-01>/FREQ=DAILY;INTERVAL=7;BYDAY=TU")
+	ass.Equal(t, "a rule that has occurrences", err.(pri.ParseErrorLike).GetExpected())
+	_, err = pri.ParseRecurrence("R/<2024-01-01>/FREQ=DAILY;INTERVAL=7;BYDAY=MO")
+	ass.Nil(t, err)
+	_, err = pri.ParseRecurrence("R/<2024-01-01>/~PT0.0005S")
+	ass.Equal(t, "a whole number of milliseconds", err.(pri.ParseErrorLike).GetExpected())
+	var primitive any
+	primitive, err = pri.ParsePrimitive("R/<2024-01-01>/FREQ=DAILY")
+	ass.Nil(t, err)
+	ass.Equal(t, "R/<2024-01-01>/FREQ=DAILY", primitive.(pri.RecurrenceLike).AsSource())
+}
+
 func TestZero(t *tes.T) {
 	var v = pri.Number(0 + 0i)
 	ass.Equal(t, 0+0i, v.AsIntrinsic())
@@ -573,6 +1054,70 @@ func TestNumberFromSource(t *tes.T) {
 	ass.Equal(t, 1.2345678e-90, v.GetAngle())
 }
 
+func TestNumberFormats(t *tes.T) {
+	ass.Equal(t, pri.Number(31), pri.NumberFromSource("0x1F"))
+	ass.Equal(t, pri.Number(-31), pri.NumberFromSource("-0x1f"))
+	ass.Equal(t, pri.Number(15), pri.NumberFromSource("0o17"))
+	ass.Equal(t, pri.Number(10), pri.NumberFromSource("0b1010"))
+	ass.Equal(t, pri.Number(12), pri.NumberFromSource("0x1.8p3"))
+	ass.Equal(t, pri.Number(0.75), pri.NumberFromSource("0x1.8p-1"))
+	ass.Equal(t, pri.Number(2+16i), pri.NumberFromSource("2+0x10i"))
+	ass.Equal(t, pri.Number(31i), pri.NumberFromSource("0x1Fi"))
+	ass.Equal(t, pri.Number(4700), pri.NumberFromSource("4.7k"))
+	ass.Equal(t, pri.Number(10e-6), pri.NumberFromSource("10µ"))
+	ass.Equal(t, pri.Number(10e-6), pri.NumberFromSource("10μ"))
+	ass.Equal(t, pri.Number(-2.5e9), pri.NumberFromSource("-2.5G"))
+	ass.Equal(t, pri.Number(1000-2i), pri.NumberFromSource("1k-2i"))
+	ass.Equal(t, pri.Number(0.01i), pri.NumberFromSource("1E-2i"))
+	var primitive, err = pri.ParsePrimitive("0x1F")
+	ass.Nil(t, err)
+	ass.Equal(t, pri.Number(31), primitive)
+	primitive, err = pri.ParsePrimitive("255n")
+	ass.Nil(t, err)
+	ass.Equal(t, pri.Integer(255), primitive)
+	var numbers = pri.NumberClass()
+	for _, source := range []string{"0X1F", "0x1P3", "1.5abc", "4.7kΩ", "1+2"} {
+		_, err = numbers.ParseNumber(source)
+		ass.Error(t, err, source)
+	}
+	_, err = numbers.ParseNumber("0X1F")
+	ass.Equal(t, uint(1), err.(pri.ParseErrorLike).GetOffset())
+	ass.Panics(t, func() { pri.NumberFromSource("0x1P3") })
+
+	var number = pri.Number(31)
+	ass.Equal(t, "0x1F", number.AsBase(16))
+	ass.Equal(t, "31", number.AsBase(10))
+	ass.Equal(t, "0o37", number.AsBase(8))
+	ass.Equal(t, "0b11111", number.AsBase(2))
+	ass.Equal(t, "-0x1.9p+03", pri.Number(-12.5).AsBase(16))
+	ass.Equal(t, "1.5", pri.Number(1.5).AsBase(2))
+	ass.Equal(t, "0b11-0b100i", pri.Number(3-4i).AsBase(2))
+	ass.Equal(t, "0", pri.Number(0).AsBase(16))
+	ass.Equal(t, "∞", pri.NumberClass().Infinity().AsBase(8))
+	for _, value := range []complex128{mat.Pi, -1e300, 0.1 + 2.5i, 1.0 / 3} {
+		var number = pri.Number(value)
+		ass.Equal(t, number, pri.NumberFromSource(number.AsBase(16)))
+	}
+
+	ass.Equal(t, "4.7k", pri.Number(4700).AsEngineering())
+	ass.Equal(t, "10µ", pri.Number(10e-6).AsEngineering())
+	ass.Equal(t, "123.456k", pri.Number(123456).AsEngineering())
+	ass.Equal(t, "-500m", pri.Number(-0.5).AsEngineering())
+	ass.Equal(t, "42", pri.Number(42).AsEngineering())
+	ass.Equal(t, "1.5Z", pri.Number(1.5e21).AsEngineering())
+	ass.Equal(t, "1E+19", pri.Number(1e19).AsEngineering())
+	ass.Equal(t, "3+4ki", pri.Number(3+4000i).AsEngineering())
+	for _, value := range []complex128{4.7e3, 2.2e-12, -330e6, 1 + 1e-3i} {
+		var number = pri.Number(value)
+		ass.Equal(t, number, pri.NumberFromSource(number.AsEngineering()))
+	}
+
+	defer func() {
+		ass.Equal(t, "A number can only be formatted in base 2, 8, 10 or 16: 3", recover())
+	}()
+	number.AsBase(3)
+}
+
 func TestNumberLibrary(t *tes.T) {
 	var class = pri.NumberClass()
 	var zero = class.Zero()
@@ -776,6 +1321,900 @@ func TestNumberLibrary(t *tes.T) {
 	ass.False(t, class.Logarithm(infinity, undefined).IsDefined())
 }
 
+func TestNumberFunctions(t *tes.T) {
+	var class = pri.NumberClass()
+	var zero = class.Zero()
+	var one = class.One()
+	var minusone = pri.Number(-1)
+	var i = class.I()
+	var minusi = pri.Number(-1i)
+	var pi = class.Pi()
+	var halfpi = pri.Number(0.5 * mat.Pi)
+	var ipi = pri.Number(complex(0, mat.Pi))
+	var infinity = class.Infinity()
+	var undefined = class.Undefined()
+
+	//	|z|
+	ass.Equal(t, pri.Number(5), class.Absolute(pri.Number(3+4i)))
+	ass.Equal(t, one, class.Absolute(minusi))
+	ass.Equal(t, class.Maximum(), class.Absolute(infinity))
+	ass.False(t, class.Absolute(undefined).IsDefined())
+
+	//	√z and the n roots of z
+	ass.Equal(t, pri.Number(2i), class.SquareRoot(pri.Number(-4)))
+	ass.Equal(t, pri.Number(3), class.SquareRoot(pri.Number(9)))
+	ass.Equal(t, []pri.NumberLike{one, i, minusone, minusi}, class.NthRoots(one, 4))
+	ass.Equal(t, []pri.NumberLike{i, minusi}, class.NthRoots(minusone, 2))
+	var roots = class.NthRoots(pri.Number(8i), 3)
+	ass.Equal(t, 3, len(roots))
+	for _, root := range roots {
+		var cube = class.Power(root, pri.Number(3)).AsIntrinsic()
+		ass.InDelta(t, 0.0, real(cube), 1e-12)
+		ass.InDelta(t, 8.0, imag(cube), 1e-12)
+	}
+	ass.Equal(t, []pri.NumberLike{zero, zero}, class.NthRoots(zero, 2))
+	ass.Equal(t, []pri.NumberLike{infinity}, class.NthRoots(infinity, 1))
+	ass.Panics(t, func() { class.NthRoots(one, 0) })
+
+	//	e^z
+	ass.Equal(t, one, class.Exponential(zero))
+	ass.Equal(t, class.E(), class.Exponential(one))
+	ass.Equal(t, minusone, class.Exponential(ipi))
+	ass.Equal(t, one, class.Exponential(pri.Number(complex(0, 2*mat.Pi))))
+	ass.Equal(t, i, class.Exponential(pri.Number(complex(0, 0.5*mat.Pi))))
+	ass.Equal(t, zero, class.Exponential(class.Minimum()))
+	ass.Equal(t, class.Maximum(), class.Exponential(class.Maximum()))
+	ass.False(t, class.Exponential(infinity).IsDefined())
+
+	//	Γ(z)
+	ass.Equal(t, pri.Number(24), class.Gamma(pri.Number(5)))
+	ass.Equal(t, class.SquareRoot(pi), class.Gamma(pri.Number(0.5)))
+	ass.Equal(t, infinity, class.Gamma(zero))
+	ass.Equal(t, infinity, class.Gamma(pri.Number(-3)))
+	var gamma = class.Gamma(i).AsIntrinsic()
+	ass.InDelta(t, -0.15494982830181, real(gamma), 1e-12)
+	ass.InDelta(t, -0.49801566811836, imag(gamma), 1e-12)
+	gamma = class.Gamma(pri.Number(-1.5 + 2i)).AsIntrinsic()
+	var shifted = class.Gamma(pri.Number(0.5 + 2i)).AsIntrinsic()
+	var expected = shifted / ((-1.5 + 2i) * (-0.5 + 2i))
+	ass.InDelta(t, real(expected), real(gamma), 1e-12)
+	ass.InDelta(t, imag(expected), imag(gamma), 1e-12)
+	ass.Equal(t, class.Maximum(), class.Gamma(class.Maximum()))
+	ass.False(t, class.Gamma(infinity).IsDefined())
+
+	//	trigonometric functions
+	ass.Equal(t, zero, class.Sine(pi))
+	ass.Equal(t, zero, class.Sine(class.Tau()))
+	ass.Equal(t, one, class.Sine(halfpi))
+	ass.Equal(t, minusone, class.Cosine(pi))
+	ass.Equal(t, zero, class.Cosine(halfpi))
+	ass.Equal(t, zero, class.Tangent(pi))
+	ass.Equal(t, infinity, class.Tangent(halfpi))
+	ass.Equal(t, halfpi, class.ArcSine(one))
+	ass.Equal(t, pi, class.ArcCosine(minusone))
+	ass.Equal(t, pri.Number(0.25*mat.Pi), class.ArcTangent(one))
+	var sine = class.Sine(pri.Number(1 + 1i)).AsIntrinsic()
+	ass.InDelta(t, mat.Sin(1)*mat.Cosh(1), real(sine), 1e-12)
+	ass.InDelta(t, mat.Cos(1)*mat.Sinh(1), imag(sine), 1e-12)
+	var z = pri.Number(0.3 - 0.7i)
+	var identity = class.Sum(
+		class.Power(class.Sine(z), pri.Number(2)),
+		class.Power(class.Cosine(z), pri.Number(2)),
+	).AsIntrinsic()
+	ass.InDelta(t, 1.0, real(identity), 1e-12)
+	ass.InDelta(t, 0.0, imag(identity), 1e-12)
+	var inverse = class.ArcSine(class.Sine(z)).AsIntrinsic()
+	ass.InDelta(t, 0.3, real(inverse), 1e-12)
+	ass.InDelta(t, -0.7, imag(inverse), 1e-12)
+	ass.False(t, class.Sine(infinity).IsDefined())
+	ass.False(t, class.Cosine(undefined).IsDefined())
+
+	//	hyperbolic functions
+	ass.Equal(t, zero, class.HyperbolicSine(zero))
+	ass.Equal(t, zero, class.HyperbolicSine(ipi))
+	ass.Equal(t, minusone, class.HyperbolicCosine(ipi))
+	ass.Equal(t, one, class.HyperbolicCosine(zero))
+	ass.Equal(t, zero, class.HyperbolicTangent(ipi))
+	ass.Equal(t, infinity, class.HyperbolicTangent(pri.Number(complex(0, 0.5*mat.Pi))))
+	ass.Equal(t, pri.Number(complex(mat.Sinh(1), 0)), class.HyperbolicSine(one))
+	ass.Equal(t, zero, class.ArcHyperbolicSine(zero))
+	ass.Equal(t, zero, class.ArcHyperbolicCosine(one))
+	ass.Equal(t, zero, class.ArcHyperbolicTangent(zero))
+	inverse = class.ArcHyperbolicTangent(class.HyperbolicTangent(z)).AsIntrinsic()
+	ass.InDelta(t, 0.3, real(inverse), 1e-12)
+	ass.InDelta(t, -0.7, imag(inverse), 1e-12)
+	ass.False(t, class.HyperbolicCosine(infinity).IsDefined())
+}
+
+func TestPrecisionPolicies(t *tes.T) {
+	var numbers = pri.NumberClass()
+	var angles = pri.AngleClass()
+	ass.Equal(t, pri.LockedPrecision, numbers.GetPrecision())
+	ass.Equal(t, uint(15), numbers.GetSignificantDigits())
+	ass.Equal(t, pri.LockedPrecision, angles.GetPrecision())
+	ass.Equal(t, uint(15), angles.GetSignificantDigits())
+	var _, err = numbers.WithPrecision(pri.Precision(7), 0)
+	ass.Error(t, err)
+	_, err = numbers.WithPrecision(pri.SignificantPrecision, 0)
+	ass.Error(t, err)
+	_, err = angles.WithPrecision(pri.SignificantPrecision, 18)
+	ass.Error(t, err)
+
+	// The locked precision hides round-off errors.
+	var sum = numbers.Sum(pri.Number(0.1), pri.Number(0.2))
+	ass.Equal(t, "0.3", sum.AsSource())
+	ass.Equal(t, numbers.One(), pri.Number(1+1e-9))
+	ass.Equal(t, numbers.I(), numbers.NumberFromPolar(1, 1.57079633))
+	ass.Equal(t, 0.0, angles.Cosine(pri.Angle(0.5*mat.Pi)))
+	ass.Equal(t, "~0.3", angles.Sum(pri.Angle(0.1), pri.Angle(0.2)).AsSource())
+
+	// The exact precision leaves double precision values unchanged.
+	exactNumbers, err := numbers.WithPrecision(pri.ExactPrecision, 0)
+	ass.NoError(t, err)
+	exactAngles, err := angles.WithPrecision(pri.ExactPrecision, 0)
+	ass.NoError(t, err)
+	ass.Equal(t, pri.ExactPrecision, exactNumbers.GetPrecision())
+	sum = exactNumbers.Sum(exactNumbers.Number(0.1), exactNumbers.Number(0.2))
+	ass.Equal(t, "0.30000000000000004", exactNumbers.FormatRectangular(sum))
+	var number = exactNumbers.Number(1 + 1e-9)
+	ass.Equal(t, "1.000000001", exactNumbers.FormatRectangular(number))
+	ass.Equal(t, mat.Sin(mat.Pi), exactNumbers.Sine(exactNumbers.Pi()).GetReal())
+	var polar = exactNumbers.NumberFromPolar(1, 1.5707963)
+	ass.Equal(t, mat.Cos(1.5707963), polar.GetReal())
+	ass.Equal(t, mat.Cos(0.5*mat.Pi), exactAngles.Cosine(pri.Angle(0.5*mat.Pi)))
+	ass.Equal(t, 1.5707963, exactAngles.Angle(1.5707963).AsIntrinsic())
+	var angle = exactAngles.Sum(exactAngles.Angle(0.1), exactAngles.Angle(0.2))
+	ass.Equal(t, "~0.30000000000000004", exactAngles.Format(angle))
+
+	// The significant precision rounds to the significant digits.
+	roundedNumbers, err := numbers.WithPrecision(pri.SignificantPrecision, 4)
+	ass.NoError(t, err)
+	roundedAngles, err := angles.WithPrecision(pri.SignificantPrecision, 3)
+	ass.NoError(t, err)
+	ass.Equal(t, uint(4), roundedNumbers.GetSignificantDigits())
+	ass.Equal(t, roundedNumbers.Number(1.235+2i), roundedNumbers.Number(1.23456+2i))
+	number = roundedNumbers.Number(1.23456 + 2i)
+	ass.Equal(t, "1.235+2i", roundedNumbers.FormatRectangular(number))
+	ass.Equal(t, roundedNumbers.Number(2i), roundedNumbers.NumberFromPolar(2, 1.5708))
+	ass.Equal(t, "2e^~1.571i", roundedNumbers.FormatPolar(pri.Number(2i)))
+	ass.Equal(t, "4.712k", roundedNumbers.FormatEngineering(pri.Number(4712.3)))
+	ass.Equal(t, 0.841, roundedAngles.Sine(pri.Angle(1)))
+	ass.Equal(t, roundedAngles.Pi(), roundedAngles.Angle(3.1416))
+	ass.Equal(t, "~1.23", roundedAngles.Format(pri.Angle(1.23456)))
+
+	// The shared classes are never changed by a policy.
+	ass.Equal(t, pri.LockedPrecision, numbers.GetPrecision())
+	ass.Equal(t, "1.23456+2i", pri.Number(1.23456+2i).AsSource())
+	ass.Equal(t, numbers.One(), pri.Number(1+1e-9))
+	ass.Equal(t, "~1.23456", pri.Angle(1.23456).AsSource())
+}
+
+func TestOrdering(t *tes.T) {
+	var numbers = pri.NumberClass()
+	var values = []pri.NumberLike{
+		numbers.Infinity(),
+		pri.Number(-1i),
+		numbers.Minimum(),
+		pri.Number(2),
+		pri.Number(-1),
+		numbers.Undefined(),
+		numbers.Maximum(),
+		pri.Number(1i),
+		numbers.Zero(),
+		pri.Number(1),
+	}
+	sli.SortFunc(values, numbers.Compare)
+	var sources []string
+	for _, value := range values {
+		sources = append(sources, value.AsSource())
+	}
+	ass.Equal(t, []string{"undefined", "0", "1", "1i", "-1", "-1i", "2", "+∞", "-∞", "∞"}, sources)
+	ass.True(t, pri.Number(1).IsBefore(pri.Number(-1)))
+	ass.False(t, pri.Number(2).IsBefore(pri.Number(2)))
+	ass.Equal(t, 0, numbers.Compare(pri.Number(3+4i), pri.Number(3+4i)))
+	ass.True(t, numbers.ApproximatelyEqual(pri.Number(1), pri.Number(1.001+0.001i), 0.01))
+	ass.False(t, numbers.ApproximatelyEqual(pri.Number(1), pri.Number(1.1), 0.01))
+	ass.True(t, numbers.ApproximatelyEqual(numbers.Maximum(), numbers.Maximum(), 0))
+	ass.False(t, numbers.ApproximatelyEqual(numbers.Maximum(), numbers.Minimum(), 1e300))
+	ass.False(t, numbers.ApproximatelyEqual(numbers.Undefined(), numbers.Undefined(), 1))
+
+	var percentages = pri.PercentageClass()
+	ass.Equal(t, -1, percentages.Compare(pri.Percentage(-5), pri.Percentage(5)))
+	ass.True(t, pri.Percentage(12.5).IsBefore(pri.Percentage(12.6)))
+	ass.True(t, percentages.ApproximatelyEqual(pri.Percentage(12.5), pri.Percentage(12.9), pri.Percentage(0.5)))
+	ass.False(t, percentages.ApproximatelyEqual(pri.Percentage(12.5), pri.Percentage(13.1), pri.Percentage(0.5)))
+
+	var probabilities = pri.ProbabilityClass()
+	ass.Equal(t, 1, probabilities.Compare(pri.Probability(0.75), pri.Probability(0.25)))
+	ass.True(t, pri.Probability(0.25).IsBefore(pri.Probability(0.75)))
+	ass.True(t, probabilities.ApproximatelyEqual(pri.Probability(0.5), pri.Probability(0.51), pri.Probability(0.02)))
+	ass.False(t, probabilities.ApproximatelyEqual(pri.Probability(0.5), pri.Probability(0.53), pri.Probability(0.02)))
+
+	var angles = pri.AngleClass()
+	ass.Equal(t, -1, angles.Compare(pri.Angle(1), angles.Pi()))
+	ass.True(t, angles.Pi().IsBefore(angles.Tau()))
+	ass.True(t, angles.ApproximatelyEqual(pri.Angle(0.01), pri.Angle(6.28), pri.Angle(0.02)))
+	ass.False(t, angles.ApproximatelyEqual(pri.Angle(0.01), pri.Angle(6.2), pri.Angle(0.02)))
+
+	var durations = pri.DurationClass()
+	var second = pri.DurationFromSource("~PT1S")
+	var minute = pri.DurationFromSource("~PT1M")
+	ass.True(t, second.IsBefore(minute))
+	ass.False(t, minute.IsBefore(second))
+	ass.True(t, durations.ApproximatelyEqual(minute, pri.DurationFromSource("~PT59.5S"), second))
+	ass.False(t, durations.ApproximatelyEqual(minute, pri.DurationFromSource("~PT58.5S"), second))
+
+	var moments = pri.MomentClass()
+	var earlier = pri.MomentFromSource("<2024-05-01T12:00:00>")
+	var later = pri.MomentFromSource("<2024-05-01T12:00:00.0000005>")
+	ass.Equal(t, -1, moments.Compare(earlier, later))
+	ass.Equal(t, 1, moments.Compare(later, earlier))
+	ass.Equal(t, 0, moments.Compare(earlier, earlier))
+	ass.True(t, earlier.IsBefore(later))
+	ass.True(t, moments.ApproximatelyEqual(later, earlier, pri.DurationFromSource("~PT0.000001S")))
+	ass.False(t, moments.ApproximatelyEqual(later, earlier, pri.DurationFromSource("~PT0.0000001S")))
+}
+
+func TestDecimals(t *tes.T) {
+	var class = pri.DecimalClass()
+	var price = pri.DecimalFromSource("19.90d")
+	ass.Equal(t, "19.90d", price.AsSource())
+	ass.Equal(t, uint(2), price.GetScale())
+	ass.Equal(t, big.NewInt(1990), price.GetUnscaled())
+	ass.Equal(t, 19.9, price.AsFloat())
+	ass.Equal(t, big.NewRat(199, 10), price.AsBigRat())
+	ass.Equal(t, price, pri.Decimal(big.NewInt(1990), 2))
+	ass.Equal(t, "0.05d", pri.Decimal(big.NewInt(5), 2).AsSource())
+	ass.Equal(t, "0.00d", pri.DecimalFromSource("-0.00d").AsSource())
+	ass.Equal(t, "42d", pri.DecimalFromInteger(42).AsSource())
+	ass.Equal(t, "0.10d", pri.DecimalFromFloat(0.1, 2).AsSource())
+	ass.True(t, pri.DecimalFromSource("-0.01d").IsNegative())
+	ass.True(t, pri.DecimalFromSource("0.000d").IsZero())
+	ass.False(t, class.One().IsZero())
+	ass.Panics(t, func() { pri.DecimalFromFloat(mat.Inf(1), 2) })
+
+	var huge = pri.DecimalFromSource("123456789012345678901234567890.123456789d")
+	ass.Equal(t, "123456789012345678901234567890.123456789d", huge.AsSource())
+	ass.Equal(
+		t,
+		"246913578024691357802469135780.246913578d",
+		class.Sum(huge, huge).AsSource(),
+	)
+
+	var tax = pri.DecimalFromSource("0.075d")
+	ass.Equal(t, "19.975d", class.Sum(price, tax).AsSource())
+	ass.Equal(t, "19.825d", class.Difference(price, tax).AsSource())
+	ass.Equal(t, "-19.90d", class.Inverse(price).AsSource())
+	ass.Equal(t, "1.49250d", class.Product(price, tax).AsSource())
+	ass.Equal(t, "1.90d", class.Remainder(price, pri.DecimalFromSource("3d")).AsSource())
+	ass.Equal(t, "-1.90d", class.Remainder(class.Inverse(price), pri.DecimalFromSource("3d")).AsSource())
+	ass.Panics(t, func() { class.Quotient(price, class.Zero(), 2, pri.HalfEvenRounding) })
+	ass.Panics(t, func() { class.Remainder(price, class.Zero()) })
+
+	var third = class.Quotient(class.One(), pri.DecimalFromInteger(3), 16, pri.HalfEvenRounding)
+	ass.Equal(t, "0.3333333333333333d", third.AsSource())
+	var share = class.Quotient(price, pri.DecimalFromSource("3.0d"), 2, pri.HalfEvenRounding)
+	ass.Equal(t, "6.63d", share.AsSource())
+	share = class.Quotient(price, pri.DecimalFromSource("3.0d"), 2, pri.DownRounding)
+	ass.Equal(t, "6.63d", share.AsSource())
+	share = class.Quotient(price, pri.DecimalFromSource("3.0d"), 2, pri.CeilingRounding)
+	ass.Equal(t, "6.64d", share.AsSource())
+	ass.Panics(t, func() { class.Quotient(price, price, 2, pri.Rounding(42)) })
+
+	var cases = []struct {
+		rounding pri.Rounding
+		results  [4]string
+	}{
+		{pri.HalfEvenRounding, [4]string{"2.12d", "2.12d", "-2.12d", "2.13d"}},
+		{pri.HalfUpRounding, [4]string{"2.13d", "2.12d", "-2.13d", "2.13d"}},
+		{pri.DownRounding, [4]string{"2.12d", "2.12d", "-2.12d", "2.12d"}},
+		{pri.CeilingRounding, [4]string{"2.13d", "2.13d", "-2.12d", "2.13d"}},
+		{pri.FloorRounding, [4]string{"2.12d", "2.12d", "-2.13d", "2.12d"}},
+	}
+	var sources = [4]string{"2.125d", "2.121d", "-2.125d", "2.1251d"}
+	for _, test := range cases {
+		for index, source := range sources {
+			var rounded = class.Rounded(pri.DecimalFromSource(source), 2, test.rounding)
+			ass.Equal(t, test.results[index], rounded.AsSource())
+		}
+	}
+	var rounded = class.Rounded(pri.DecimalFromSource("2.125d"), 4, pri.FloorRounding)
+	ass.Equal(t, "2.1250d", rounded.AsSource())
+	ass.Panics(t, func() {
+		class.Rounded(pri.DecimalFromSource("2.125d"), 2, pri.Rounding(42))
+	})
+
+	var _, err = pri.ParseDecimal("01.5d")
+	ass.NotNil(t, err)
+	var primitive any
+	primitive, err = pri.ParsePrimitive("-3.50d")
+	ass.Nil(t, err)
+	ass.Equal(t, pri.DecimalFromSource("-3.50d"), primitive)
+}
+
+func TestRationals(t *tes.T) {
+	var class = pri.RationalClass()
+	var half = pri.RationalFromSource("4/8")
+	ass.Equal(t, "1/2", half.AsSource())
+	ass.Equal(t, half, pri.Rational(-3, -6))
+	ass.Equal(t, "-3/4", pri.Rational(3, -4).AsSource())
+	ass.Equal(t, "5/1", pri.Rational(5, 1).AsSource())
+	ass.Equal(t, "0/1", pri.RationalFromSource("-0/7").AsSource())
+	ass.Equal(t, big.NewInt(1), half.GetNumerator())
+	ass.Equal(t, big.NewInt(2), half.GetDenominator())
+	ass.Equal(t, big.NewRat(1, 2), half.AsBigRat())
+	ass.Equal(t, 0.5, half.AsFloat())
+	ass.True(t, class.Zero().IsZero())
+	ass.True(t, pri.Rational(-1, 3).IsNegative())
+	ass.Panics(t, func() { pri.Rational(1, 0) })
+	var _, err = pri.ParseRational("1/0")
+	ass.NotNil(t, err)
+
+	var third = pri.Rational(1, 3)
+	ass.Equal(t, "5/6", class.Sum(half, third).AsSource())
+	ass.Equal(t, "1/6", class.Difference(half, third).AsSource())
+	ass.Equal(t, "1/6", class.Product(half, third).AsSource())
+	ass.Equal(t, "3/2", class.Quotient(half, third).AsSource())
+	ass.Equal(t, "-1/2", class.Inverse(half).AsSource())
+	ass.Equal(t, "3/1", class.Reciprocal(third).AsSource())
+	ass.Equal(t, "1/27", class.Power(third, 3).AsSource())
+	ass.Equal(t, "9/1", class.Power(third, -2).AsSource())
+	ass.Equal(t, class.One(), class.Power(third, 0))
+	var minusOne = pri.Rational(-1, 1)
+	ass.Equal(t, class.One(), class.Power(minusOne, mat.MinInt))
+	ass.Equal(t, minusOne, class.Power(minusOne, mat.MinInt+1))
+	ass.Equal(t, class.One(), class.Power(class.One(), mat.MinInt))
+	ass.PanicsWithValue(t, "A rational cannot be divided by zero.", func() {
+		class.Quotient(half, class.Zero())
+	})
+	ass.Panics(t, func() { class.Reciprocal(class.Zero()) })
+	var sum = class.Zero()
+	for range 10 {
+		sum = class.Sum(sum, pri.Rational(1, 10))
+	}
+	ass.Equal(t, class.One(), sum)
+
+	ass.Equal(t, "1/10", pri.RationalFromNumber(pri.Number(0.1)).AsSource())
+	ass.Equal(t, "-3/2", pri.RationalFromNumber(pri.Number(-1.5)).AsSource())
+	ass.Equal(t, "21/50", pri.RationalFromPercentage(pri.Percentage(42)).AsSource())
+	ass.Equal(t, "1/10", pri.RationalFromProbability(pri.Probability(0.1)).AsSource())
+	ass.Panics(t, func() { pri.RationalFromNumber(pri.Number(3 + 4i)) })
+	ass.Equal(t, pri.Number(0.5), half.AsNumber())
+	ass.Equal(t, pri.Percentage(50), half.AsPercentage())
+	ass.Equal(t, pri.Probability(0.5), half.AsProbability())
+	ass.Equal(t, pri.Probability(1), pri.Rational(3, 2).AsProbability())
+
+	ass.Equal(t, "355/113", class.Approximation(mat.Pi, 1000).AsSource())
+	ass.Equal(t, "22/7", class.Approximation(mat.Pi, 10).AsSource())
+	ass.Equal(t, "311/99", class.Approximation(mat.Pi, 100).AsSource())
+	ass.Equal(t, "3/1", class.Approximation(mat.Pi, 1).AsSource())
+	ass.Equal(t, "-22/7", class.Approximation(-mat.Pi, 10).AsSource())
+	ass.Equal(t, "1/3", class.Approximation(1.0/3.0, 10).AsSource())
+	ass.Equal(t, "3/4", class.Approximation(0.75, 10).AsSource())
+	ass.Equal(t, "1/1", class.Approximation(0.99, 10).AsSource())
+	ass.Panics(t, func() { class.Approximation(mat.Pi, 0) })
+	ass.Panics(t, func() { class.Approximation(mat.NaN(), 10) })
+}
+
+func TestIntegers(t *tes.T) {
+	var class = pri.IntegerClass()
+	var answer = pri.Integer(42)
+	ass.Equal(t, "42n", answer.AsSource())
+	ass.Equal(t, 42, answer.AsInteger())
+	ass.Equal(t, big.NewInt(42), answer.AsBigInt())
+	for _, source := range []string{"2r101010n", "8r52n", "16r2An", "16r2an", "32r1An", "64rqn", "42n"} {
+		ass.Equal(t, answer, pri.IntegerFromSource(source))
+	}
+	ass.Equal(t, "2r101010n", answer.AsBase(2))
+	ass.Equal(t, "8r52n", answer.AsBase(8))
+	ass.Equal(t, "42n", answer.AsBase(10))
+	ass.Equal(t, "16r2An", answer.AsBase(16))
+	ass.Equal(t, "32r1An", answer.AsBase(32))
+	ass.Equal(t, "64rqn", answer.AsBase(64))
+	ass.Equal(t, "-16rFFn", pri.Integer(-255).AsBase(16))
+	ass.Equal(t, "64rAn", class.Zero().AsBase(64))
+	ass.Equal(t, "0n", pri.IntegerFromSource("-0n").AsSource())
+	ass.Panics(t, func() { answer.AsBase(12) })
+	var _, err = pri.ParseInteger("8r9n")
+	ass.NotNil(t, err)
+
+	var huge = pri.IntegerFromSource("123456789012345678901234567890n")
+	ass.Equal(t, huge, pri.IntegerFromSource(huge.AsBase(64)))
+	ass.Equal(t, huge, pri.IntegerFromSource(huge.AsBase(32)))
+	ass.Equal(t, mat.MaxInt, huge.AsInteger())
+	ass.Equal(t, mat.MinInt, class.Inverse(huge).AsInteger())
+	var integer, ok = huge.AsCheckedInteger()
+	ass.False(t, ok)
+	ass.Equal(t, 0, integer)
+	integer, ok = pri.Integer(mat.MinInt).AsCheckedInteger()
+	ass.True(t, ok)
+	ass.Equal(t, mat.MinInt, integer)
+	var overflow = class.Sum(pri.Integer(mat.MaxInt), class.One())
+	ass.Equal(t, "9223372036854775808n", overflow.AsSource())
+	_, ok = overflow.AsCheckedInteger()
+	ass.False(t, ok)
+
+	var seven = pri.Integer(7)
+	ass.Equal(t, pri.Integer(49), class.Sum(answer, seven))
+	ass.Equal(t, pri.Integer(35), class.Difference(answer, seven))
+	ass.Equal(t, pri.Integer(294), class.Product(answer, seven))
+	ass.Equal(t, pri.Integer(-6), class.Quotient(pri.Integer(-45), seven))
+	ass.Equal(t, pri.Integer(-3), class.Remainder(pri.Integer(-45), seven))
+	ass.Equal(t, "1267650600228229401496703205376n", class.Power(pri.Integer(2), 100).AsSource())
+	ass.Equal(t, pri.Integer(445), class.ModularPower(pri.Integer(4), pri.Integer(13), pri.Integer(497)))
+	ass.Equal(t, pri.Integer(5), class.ModularPower(pri.Integer(3), pri.Integer(-1), pri.Integer(7)))
+	ass.Panics(t, func() { class.ModularPower(pri.Integer(2), pri.Integer(-1), pri.Integer(4)) })
+	ass.Panics(t, func() { class.ModularPower(pri.Integer(2), seven, class.Zero()) })
+	ass.Equal(t, pri.Integer(6), class.Gcd(pri.Integer(-54), pri.Integer(24)))
+	ass.Equal(t, pri.Integer(351364182882014), class.SquareRoot(huge))
+	ass.Equal(t, pri.Integer(6), class.SquareRoot(answer))
+	ass.Panics(t, func() { class.SquareRoot(pri.Integer(-1)) })
+	ass.Panics(t, func() { class.Quotient(answer, class.Zero()) })
+	ass.Panics(t, func() { class.Remainder(answer, class.Zero()) })
+
+	ass.Equal(t, pri.Integer(-43), class.Not(answer))
+	ass.Equal(t, pri.Integer(2), class.And(answer, seven))
+	ass.Equal(t, pri.Integer(40), class.San(answer, seven))
+	ass.Equal(t, pri.Integer(47), class.Ior(answer, seven))
+	ass.Equal(t, pri.Integer(45), class.Xor(answer, seven))
+	ass.Equal(t, pri.Integer(336), class.ShiftLeft(answer, 3))
+	ass.Equal(t, pri.Integer(5), class.ShiftRight(answer, 3))
+	ass.Equal(t, pri.Integer(-6), class.ShiftRight(pri.Integer(-42), 3))
+}
+
+func TestStandardLibraryBridges(t *tes.T) {
+	var zone = tim.FixedZone("IST", 19800)
+	var time = tim.Date(2024, 5, 1, 17, 30, 0, 123456789, zone)
+	var moment = pri.MomentFromTime(time)
+	ass.Equal(t, "<2024-05-01T12:00:00.123456789>", moment.AsSource())
+	ass.True(t, time.Equal(moment.AsTime()))
+	ass.Equal(t, tim.UTC, moment.AsTime().Location())
+	ass.Equal(t, pri.Moment(-5), pri.MomentFromTime(tim.UnixMilli(-5)))
+
+	var duration = pri.DurationFromTimeDuration(-1500 * tim.Microsecond)
+	ass.Equal(t, "~-PT0.001500S", duration.AsSource())
+	ass.Equal(t, -1500*tim.Microsecond, duration.AsTimeDuration())
+	ass.Equal(t, tim.Duration(mat.MaxInt64), pri.DurationFromSource("~P300Y").AsTimeDuration())
+	ass.Equal(t, tim.Duration(mat.MinInt64), pri.DurationFromSource("~-P300Y").AsTimeDuration())
+
+	var number = pri.NumberFromBigFloat(big.NewFloat(1.5))
+	ass.Equal(t, "1.5", number.AsSource())
+	ass.Equal(t, 0, number.AsBigFloat().Cmp(big.NewFloat(1.5)))
+	number = pri.NumberFromBigRat(big.NewRat(3, 4))
+	ass.Equal(t, "0.75", number.AsSource())
+	ass.Equal(t, big.NewRat(3, 4), number.AsBigRat())
+	ass.Nil(t, pri.NumberFromSource("3+4i").AsBigFloat())
+	ass.Nil(t, pri.NumberClass().Infinity().AsBigRat())
+	ass.Nil(t, pri.NumberClass().Undefined().AsBigFloat())
+
+	var url, _ = uri.Parse("https://craterdog.com/about?page=2#top")
+	var resource = pri.ResourceFromUri(url)
+	ass.Equal(t, "<https://craterdog.com/about?page=2#top>", resource.AsSource())
+	ass.Equal(t, url, resource.AsUri())
+	var relative, _ = uri.Parse("about")
+	ass.Panics(t, func() { pri.ResourceFromUri(relative) })
+	ass.Panics(t, func() { pri.ResourceFromUri(nil) })
+
+	ass.Equal(t, "v1.2", pri.VersionFromSemver("1.2.0").AsSource())
+	ass.Equal(t, "v1.2.3", pri.VersionFromSemver("v1.2.3+build.7").AsSource())
+	ass.Equal(t, "1.2.0", pri.VersionFromSource("v1.2").AsSemver())
+	ass.Equal(t, "", pri.VersionFromSource("v1.2.3.4").AsSemver())
+	ass.Panics(t, func() { pri.VersionFromSemver("1.2.3-beta") })
+	ass.Panics(t, func() { pri.VersionFromSemver("1.0.3") })
+	ass.Panics(t, func() { pri.VersionFromSemver("0.3.1") })
+	ass.Panics(t, func() { pri.VersionFromSemver("1.2") })
+}
+
+func TestParseErrors(t *tes.T) {
+	var moment, err = pri.ParseMoment("<2024-05-01T12>")
+	ass.Nil(t, err)
+	ass.Equal(t, "<2024-05-01T12>", moment.AsSource())
+
+	_, err = pri.ParseMoment("<2024-13-01>")
+	var parseError, ok = err.(pri.ParseErrorLike)
+	ass.True(t, ok)
+	ass.Equal(t, "moment", parseError.GetKind())
+	ass.Equal(t, "<2024-13-01>", parseError.GetSource())
+	ass.Equal(t, uint(7), parseError.GetOffset())
+	ass.Equal(t, "<2024-1", parseError.GetPrefix())
+	ass.Equal(t, uint(1), parseError.GetLine())
+	ass.Equal(t, uint(8), parseError.GetColumn())
+	ass.Equal(t, "month 01-12", parseError.GetExpected())
+
+	_, err = pri.ParseMoment("<2024-02-30>")
+	parseError, ok = err.(pri.ParseErrorLike)
+	ass.True(t, ok)
+	ass.Equal(t, uint(1), parseError.GetOffset())
+
+	_, err = pri.ParseMoment("<2024-01-0")
+	parseError, ok = err.(pri.ParseErrorLike)
+	ass.True(t, ok)
+	ass.Equal(t, uint(10), parseError.GetOffset())
+	ass.Equal(t, "day 01-31", parseError.GetExpected())
+
+	_, err = pri.ParseMoment("<2024-05-01x")
+	parseError, ok = err.(pri.ParseErrorLike)
+	ass.True(t, ok)
+	ass.Equal(t, `"T" or time zone or ">"`, parseError.GetExpected())
+
+	_, err = pri.ParseBinary("'>\n    ab!")
+	parseError, ok = err.(pri.ParseErrorLike)
+	ass.True(t, ok)
+	ass.Equal(t, uint(2), parseError.GetLine())
+	ass.Equal(t, uint(7), parseError.GetColumn())
+	ass.Equal(t, "line of base 64 characters", parseError.GetExpected())
+
+	_, err = pri.ParseVersion("1.2")
+	parseError, ok = err.(pri.ParseErrorLike)
+	ass.True(t, ok)
+	ass.Equal(t, "version", parseError.GetKind())
+	ass.Equal(t, uint(0), parseError.GetOffset())
+
+	_, err = pri.ParseSymbol("$1")
+	parseError, ok = err.(pri.ParseErrorLike)
+	ass.True(t, ok)
+	ass.Equal(t, "symbol", parseError.GetKind())
+	ass.Equal(t, uint(1), parseError.GetOffset())
+
+	var version pri.VersionLike
+	version, err = pri.ParseVersion("v1.2")
+	ass.Nil(t, err)
+	ass.Equal(t, "v1.2", version.AsSource())
+
+	ass.Panics(t, func() { pri.MomentFromSource("<2024-13-01>") })
+	ass.Panics(t, func() { pri.TagFromSource("#") })
+}
+
+func TestParsePrimitive(t *tes.T) {
+	var primitive, err = pri.ParsePrimitive("~π")
+	ass.Nil(t, err)
+	ass.Equal(t, pri.AngleClass().Pi(), primitive)
+
+	primitive, err = pri.ParsePrimitive("~P3D")
+	ass.Nil(t, err)
+	ass.Equal(t, "~P3D", primitive.(pri.DurationLike).AsSource())
+
+	primitive, err = pri.ParsePrimitive("<2024-01-02>")
+	ass.Nil(t, err)
+	ass.Equal(t, "<2024-01-02>", primitive.(pri.MomentLike).AsSource())
+
+	primitive, err = pri.ParsePrimitive("<https://craterdog.com/about>")
+	ass.Nil(t, err)
+	ass.Equal(t, "<https://craterdog.com/about>", primitive.(pri.ResourceLike).AsSource())
+
+	var tag = pri.TagWithSize(8).AsSource()
+	primitive, err = pri.ParsePrimitive(tag)
+	ass.Nil(t, err)
+	ass.Equal(t, tag, primitive.(pri.TagLike).AsSource())
+
+	primitive, err = pri.ParsePrimitive("v1.2")
+	ass.Nil(t, err)
+	ass.Equal(t, "v1.2", primitive.(pri.VersionLike).AsSource())
+
+	primitive, err = pri.ParsePrimitive("$symbol")
+	ass.Nil(t, err)
+	ass.Equal(t, "$symbol", primitive.(pri.SymbolLike).AsSource())
+
+	primitive, err = pri.ParsePrimitive("/ab/cd")
+	ass.Nil(t, err)
+	ass.Equal(t, "/ab/cd", primitive.(pri.NameLike).AsSource())
+
+	primitive, err = pri.ParsePrimitive("'><'")
+	ass.Nil(t, err)
+	ass.Equal(t, "'><'", primitive.(pri.BinaryLike).AsSource())
+
+	primitive, err = pri.ParsePrimitive("'a'")
+	ass.Nil(t, err)
+	ass.Equal(t, "'a'", primitive.(pri.GlyphLike).AsSource())
+
+	primitive, err = pri.ParsePrimitive(`"Hello World!"`)
+	ass.Nil(t, err)
+	ass.Equal(t, `"Hello World!"`, primitive.(pri.QuoteLike).AsSource())
+
+	primitive, err = pri.ParsePrimitive("p0.5")
+	ass.Nil(t, err)
+	ass.Equal(t, "p0.5", primitive.(pri.ProbabilityLike).AsSource())
+
+	primitive, err = pri.ParsePrimitive("42%")
+	ass.Nil(t, err)
+	ass.Equal(t, "42%", primitive.(pri.PercentageLike).AsSource())
+
+	primitive, err = pri.ParsePrimitive("-1.5")
+	ass.Nil(t, err)
+	ass.Equal(t, "-1.5", primitive.(pri.NumberLike).AsSource())
+
+	primitive, err = pri.ParsePrimitive("true")
+	ass.Nil(t, err)
+	ass.Equal(t, "true", primitive.(pri.BooleanLike).AsSource())
+
+	primitive, err = pri.ParsePrimitive("none")
+	ass.Nil(t, err)
+	ass.Equal(t, "none", primitive.(pri.PatternLike).AsSource())
+
+	primitive, err = pri.ParsePrimitive("hello")
+	ass.Nil(t, err)
+	ass.Equal(t, "hello", primitive.(pri.IdentifierLike).AsSource())
+
+	// The scanner and ParsePrimitive share the same precedence.
+	primitive, err = pri.ParsePrimitive("pi")
+	ass.Nil(t, err)
+	ass.Equal(t, pri.NumberClass().Pi(), primitive)
+	var scanner = pri.Scanner(sts.NewReader("pi"))
+	ass.True(t, scanner.ScanToken())
+	ass.Equal(t, "number", scanner.GetKind())
+	ass.Equal(t, primitive, scanner.GetPrimitive())
+
+	_, err = pri.ParsePrimitive(" pi")
+	ass.NotNil(t, err)
+
+	_, err = pri.ParsePrimitive("<2024-13-01>")
+	var parseError, ok = err.(pri.ParseErrorLike)
+	ass.True(t, ok)
+	ass.Equal(t, "moment", parseError.GetKind())
+	ass.Equal(t, uint(7), parseError.GetOffset())
+
+	_, err = pri.ParsePrimitive("<2024-02-30>")
+	parseError, ok = err.(pri.ParseErrorLike)
+	ass.True(t, ok)
+	ass.Equal(t, "moment", parseError.GetKind())
+	ass.Equal(t, "a date-time that exists on the calendar", parseError.GetExpected())
+
+	_, err = pri.ParsePrimitive("$symbol!")
+	parseError, ok = err.(pri.ParseErrorLike)
+	ass.True(t, ok)
+	ass.Equal(t, "symbol", parseError.GetKind())
+	ass.Equal(t, uint(7), parseError.GetOffset())
+	ass.Equal(t, "the end of the source", parseError.GetExpected())
+}
+
+func TestFullMatches(t *tes.T) {
+	var parsers = []struct {
+		source string
+		parse  func(source string) error
+	}{
+		{"~π", func(s string) error { var _, err = pri.ParseAngle(s); return err }},
+		{"true", func(s string) error { var _, err = pri.ParseBoolean(s); return err }},
+		{"9.99d", func(s string) error { var _, err = pri.ParseDecimal(s); return err }},
+		{"~P3D", func(s string) error { var _, err = pri.ParseDuration(s); return err }},
+		{"'a'", func(s string) error { var _, err = pri.ParseGlyph(s); return err }},
+		{"255n", func(s string) error { var _, err = pri.ParseInteger(s); return err }},
+		{"<2024-01-01>/~P1D", func(s string) error { var _, err = pri.ParseInterval(s); return err }},
+		{"<2024-01-02>", func(s string) error { var _, err = pri.ParseMoment(s); return err }},
+		{"0x1F", func(s string) error { var _, err = pri.ParseNumber(s); return err }},
+		{"42%", func(s string) error { var _, err = pri.ParsePercentage(s); return err }},
+		{"p0.5", func(s string) error { var _, err = pri.ParseProbability(s); return err }},
+		{"2/3", func(s string) error { var _, err = pri.ParseRational(s); return err }},
+		{"R/<2024-01-01>/FREQ=DAILY", func(s string) error { var _, err = pri.ParseRecurrence(s); return err }},
+		{"<https://craterdog.com/about>", func(s string) error { var _, err = pri.ParseResource(s); return err }},
+		{pri.Binary([]byte{1, 2, 3}).AsSource(), func(s string) error { var _, err = pri.ParseBinary(s); return err }},
+		{pri.Bytecode([]uint16{1, 2}).AsSource(), func(s string) error { var _, err = pri.ParseBytecode(s); return err }},
+		{"hello", func(s string) error { var _, err = pri.ParseIdentifier(s); return err }},
+		{"[[1, 0], [0, 1]]", func(s string) error { var _, err = pri.ParseMatrix(s); return err }},
+		{"/ab/cd", func(s string) error { var _, err = pri.ParseName(s); return err }},
+		{pri.Narrative([]string{"Hello", "World!"}).AsSource(), func(s string) error { var _, err = pri.ParseNarrative(s); return err }},
+		{pri.Pattern([]rune("a+")).AsSource(), func(s string) error { var _, err = pri.ParsePattern(s); return err }},
+		{`"Hello World!"`, func(s string) error { var _, err = pri.ParseQuote(s); return err }},
+		{"$symbol", func(s string) error { var _, err = pri.ParseSymbol(s); return err }},
+		{pri.TagWithSize(8).AsSource(), func(s string) error { var _, err = pri.ParseTag(s); return err }},
+		{"[1, 2i]", func(s string) error { var _, err = pri.ParseVector(s); return err }},
+		{"v1.2", func(s string) error { var _, err = pri.ParseVersion(s); return err }},
+	}
+	for _, parser := range parsers {
+		ass.NoError(t, parser.parse(parser.source), parser.source)
+		var err = parser.parse(parser.source + " junk")
+		ass.Error(t, err, parser.source)
+		var parseError, ok = err.(pri.ParseErrorLike)
+		ass.True(t, ok, parser.source)
+		ass.GreaterOrEqual(t, parseError.GetOffset(), uint(len(parser.source)), parser.source)
+	}
+	var _, err = pri.ParsePrimitive("<2024-01-02>junk")
+	ass.Error(t, err)
+	ass.Panics(t, func() { pri.MomentFromSource("<2024-01-02>junk") })
+}
+
+func TestScanner(t *tes.T) {
+	var source = `!>
+    A comment that spans
+    multiple lines.
+<!
+~π <2024-01-02> ! A note.
+$symbol /ab/cd true trueish 42% p0.5 -1.5 9.99d 2/3 255n v1.2 [1, 2i] [[1, 0], [0, 1]] '>
+    abcd1234
+<' "Hello World!"
+`
+	var expected = []struct {
+		kind   string
+		source string
+	}{
+		{"angle", "~π"},
+		{"moment", "<2024-01-02>"},
+		{"symbol", "$symbol"},
+		{"name", "/ab/cd"},
+		{"boolean", "true"},
+		{"identifier", "trueish"},
+		{"percentage", "42%"},
+		{"probability", "p0.5"},
+		{"number", "-1.5"},
+		{"decimal", "9.99d"},
+		{"rational", "2/3"},
+		{"integer", "255n"},
+		{"version", "v1.2"},
+		{"vector", "[1, 2i]"},
+		{"matrix", "[[1, 0], [0, 1]]"},
+		{"binary", "'>\n    abcd1234\n<'"},
+		{"quote", `"Hello World!"`},
+	}
+	var scanner = pri.Scanner(iot.OneByteReader(sts.NewReader(source)))
+	for _, token := range expected {
+		ass.True(t, scanner.ScanToken())
+		ass.Equal(t, token.kind, scanner.GetKind())
+		ass.Equal(t, token.source, source[scanner.GetStart():scanner.GetEnd()])
+		var primitive, ok = scanner.GetPrimitive().(interface{ AsSource() string })
+		ass.True(t, ok)
+		ass.Equal(t, token.source, primitive.AsSource())
+	}
+	ass.False(t, scanner.ScanToken())
+	ass.Nil(t, scanner.GetError())
+
+	scanner = pri.Scanner(sts.NewReader("$symbol <2024-13-01>"))
+	ass.True(t, scanner.ScanToken())
+	ass.False(t, scanner.ScanToken())
+	ass.Equal(t, uint(8), scanner.GetStart())
+	var parseError, ok = scanner.GetError().(pri.ParseErrorLike)
+	ass.True(t, ok)
+	ass.Equal(t, "moment", parseError.GetKind())
+	ass.Equal(t, uint(7), parseError.GetOffset())
+
+	scanner = pri.Scanner(sts.NewReader("!> unterminated"))
+	ass.False(t, scanner.ScanToken())
+	ass.NotNil(t, scanner.GetError())
+
+	// A malformed token fails without reading the rest of the source.
+	var rest = sts.NewReader(sts.Repeat("x", 1<<24))
+	scanner = pri.Scanner(io.MultiReader(sts.NewReader("$symbol @bad "), rest))
+	ass.True(t, scanner.ScanToken())
+	ass.False(t, scanner.ScanToken())
+	ass.Equal(t, uint(8), scanner.GetStart())
+	parseError, ok = scanner.GetError().(pri.ParseErrorLike)
+	ass.True(t, ok)
+	ass.Equal(t, uint(0), parseError.GetOffset())
+	ass.Greater(t, rest.Len(), 1<<24-3*4096)
+
+	// A token that never ends fails once the lookahead limit is reached.
+	rest = sts.NewReader(sts.Repeat("x", 1<<24))
+	scanner = pri.Scanner(io.MultiReader(sts.NewReader("\">\n"), rest))
+	ass.False(t, scanner.ScanToken())
+	ass.NotNil(t, scanner.GetError())
+	ass.Greater(t, rest.Len(), 1<<24-(1<<21))
+}
+
+func TestJsonEncodings(t *tes.T) {
+	var sources = []string{
+		"~π",
+		"false",
+		"~P3D",
+		"~-PT1M",
+		"~-PT1.000000001S",
+		"<2024-05-01T12:00:00.123456789>",
+		"'a'",
+		"<2024-01-02>",
+		"-1.5",
+		"3+4i",
+		"-12.50d",
+		"-3/4",
+		"-123456789012345678901234567890n",
+		"42%",
+		"p0.5",
+		"<https://craterdog.com/about>",
+		"'><'",
+		"'>\n    abcd1234\n<'",
+		"$symbol",
+		"/ab/cd",
+		"v1.2",
+		"[1, 2i, -3]",
+		"[[1, 2], [3, ∞]]",
+	}
+	for _, encoding := range []pri.Encoding{pri.SourceEncoding, pri.NativeEncoding} {
+		for _, source := range sources {
+			var primitive, err = pri.ParsePrimitive(source)
+			ass.Nil(t, err)
+			var bytes []byte
+			bytes, err = pri.JsonClass().Marshal(primitive, encoding)
+			ass.Nil(t, err)
+			var copy = ref.New(ref.TypeOf(primitive))
+			err = pri.JsonClass().Unmarshal(bytes, encoding, copy.Interface())
+			ass.Nil(t, err)
+			ass.Equal(t, primitive, copy.Elem().Interface())
+		}
+	}
+	var moment = pri.MomentFromSource("<2024-01-02>")
+	var bytes, err = jsn.Marshal(moment)
+	ass.Nil(t, err)
+	ass.Equal(t, `"\u003c2024-01-02\u003e"`, string(bytes))
+	bytes, err = pri.JsonClass().Marshal(moment, pri.NativeEncoding)
+	ass.Nil(t, err)
+	ass.Equal(t, `1704153600000`, string(bytes))
+	bytes, err = pri.JsonClass().Marshal(
+		pri.MomentFromSource("<2024-01-02T00:00:00.0000005>"),
+		pri.NativeEncoding,
+	)
+	ass.Nil(t, err)
+	ass.Equal(t, `"\u003c2024-01-02T00:00:00.000000500\u003e"`, string(bytes))
+	bytes, err = pri.JsonClass().Marshal(pri.NumberClass().Infinity(), pri.NativeEncoding)
+	ass.Nil(t, err)
+	ass.Equal(t, `"∞"`, string(bytes))
+	_, err = pri.JsonClass().Marshal(moment, pri.Encoding(2))
+	ass.NotNil(t, err)
+
+	type NativeRecord struct {
+		Created     pri.NativePrimitive[pri.MomentLike]      `json:"created"`
+		Likelihood  pri.NativePrimitive[pri.ProbabilityLike] `json:"likelihood"`
+		Content     pri.NativePrimitive[pri.BinaryLike]      `json:"content"`
+		Version     pri.NativePrimitive[pri.VersionLike]     `json:"version"`
+		Coordinates pri.NativePrimitive[pri.NumberLike]      `json:"coordinates"`
+		Missing     pri.NativePrimitive[pri.TagLike]         `json:"missing"`
+	}
+	var native = NativeRecord{
+		Created:     pri.NativePrimitive[pri.MomentLike]{moment},
+		Likelihood:  pri.NativePrimitive[pri.ProbabilityLike]{pri.ProbabilityFromSource("p0.25")},
+		Content:     pri.NativePrimitive[pri.BinaryLike]{pri.Binary([]byte("abc"))},
+		Version:     pri.NativePrimitive[pri.VersionLike]{pri.VersionFromSource("v1.2.3")},
+		Coordinates: pri.NativePrimitive[pri.NumberLike]{pri.NumberFromSource("3+4i")},
+	}
+	bytes, err = jsn.Marshal(native)
+	ass.Nil(t, err)
+	ass.Equal(
+		t,
+		`{"created":1704153600000,"likelihood":0.25,"content":"YWJj","version":[1,2,3],"coordinates":[3,4],"missing":null}`,
+		string(bytes),
+	)
+	var nativeCopy NativeRecord
+	err = jsn.Unmarshal(bytes, &nativeCopy)
+	ass.Nil(t, err)
+	ass.Equal(t, native, nativeCopy)
+	err = jsn.Unmarshal([]byte(`{"likelihood":1.5}`), &nativeCopy)
+	ass.NotNil(t, err)
+	err = jsn.Unmarshal([]byte(`{"version":[]}`), &nativeCopy)
+	ass.NotNil(t, err)
+
+	var anything pri.NativePrimitive[any]
+	for _, value := range []string{`0.5`, `1714564800000`, `"abc"`, `[1,2]`} {
+		err = jsn.Unmarshal([]byte(value), &anything)
+		ass.NotNil(t, err)
+		ass.Nil(t, anything.Value)
+	}
+	err = jsn.Unmarshal([]byte(`true`), &anything)
+	ass.Nil(t, err)
+	ass.Equal(t, pri.Boolean(true), anything.Value)
+
+	type Record struct {
+		Created     pri.Primitive[pri.MomentLike]      `json:"created"`
+		Likelihood  pri.Primitive[pri.ProbabilityLike] `json:"likelihood"`
+		Content     pri.Primitive[pri.BinaryLike]      `json:"content"`
+		Version     pri.Primitive[pri.VersionLike]     `json:"version"`
+		Coordinates pri.Primitive[pri.NumberLike]      `json:"coordinates"`
+		Missing     pri.Primitive[pri.TagLike]         `json:"missing"`
+	}
+	var record = Record{
+		Created:     pri.Primitive[pri.MomentLike](native.Created),
+		Likelihood:  pri.Primitive[pri.ProbabilityLike](native.Likelihood),
+		Content:     pri.Primitive[pri.BinaryLike](native.Content),
+		Version:     pri.Primitive[pri.VersionLike](native.Version),
+		Coordinates: pri.Primitive[pri.NumberLike](native.Coordinates),
+	}
+	bytes, err = jsn.Marshal(record)
+	ass.Nil(t, err)
+	ass.Equal(
+		t,
+		`{"created":"\u003c2024-01-02\u003e","likelihood":"p0.25","content":"'\u003e\n    YWJj\n\u003c'","version":"v1.2.3","coordinates":"3+4i","missing":""}`,
+		string(bytes),
+	)
+	var copy Record
+	err = jsn.Unmarshal(bytes, &copy)
+	ass.Nil(t, err)
+	ass.Equal(t, record, copy)
+}
+
 func TestZeroPercentages(t *tes.T) {
 	var v = pri.Percentage(0.0)
 	ass.Equal(t, 0.0, v.AsFloat())
@@ -896,6 +2335,76 @@ func TestProbabilitieLibrary(t *tes.T) {
 	ass.Equal(t, xor, class.Xor(F, F))
 }
 
+func TestPrimitiveText(t *tes.T) {
+	var sources = []string{
+		"~π",
+		"false",
+		"~P3D",
+		"~-PT1M",
+		"~-PT1.000000001S",
+		"<2024-05-01T12:00:00.123456789>",
+		"'a'",
+		"<2024-01-02>",
+		"-1.5",
+		"42%",
+		"p0.5",
+		"<https://craterdog.com/about>",
+		"'><'",
+		"$symbol",
+		"/ab/cd",
+		"v1.2",
+	}
+	for _, source := range sources {
+		var primitive, err = pri.ParsePrimitive(source)
+		ass.Nil(t, err)
+		var marshaler = primitive.(enc.TextMarshaler)
+		var text []byte
+		text, err = marshaler.MarshalText()
+		ass.Nil(t, err)
+		ass.Equal(t, source, string(text))
+	}
+
+	type Record struct {
+		Created pri.Primitive[pri.MomentLike]     `json:"created"`
+		Timeout pri.Primitive[pri.DurationLike]   `json:"timeout"`
+		Label   pri.Primitive[pri.IdentifierLike] `json:"label"`
+		Version pri.Primitive[pri.VersionLike]    `json:"version"`
+		Value   pri.Primitive[any]                `json:"value"`
+		Missing pri.Primitive[pri.TagLike]        `json:"missing"`
+	}
+	var record = Record{
+		Created: pri.Primitive[pri.MomentLike]{pri.MomentFromSource("<2024-01-02>")},
+		Timeout: pri.Primitive[pri.DurationLike]{pri.DurationFromSource("~PT5M")},
+		Label:   pri.Primitive[pri.IdentifierLike]{pri.IdentifierFromSource("pi")},
+		Version: pri.Primitive[pri.VersionLike]{pri.VersionFromSource("v1.2.3")},
+		Value:   pri.Primitive[any]{pri.PercentageFromSource("42%")},
+	}
+	var bytes, err = jsn.Marshal(record)
+	ass.Nil(t, err)
+	ass.Equal(
+		t,
+		`{"created":"\u003c2024-01-02\u003e","timeout":"~PT5M","label":"pi","version":"v1.2.3","value":"42%","missing":""}`,
+		string(bytes),
+	)
+	var copy Record
+	err = jsn.Unmarshal(bytes, &copy)
+	ass.Nil(t, err)
+	ass.Equal(t, record, copy)
+
+	err = jsn.Unmarshal([]byte(`{"created":"~P3D"}`), &copy)
+	ass.NotNil(t, err)
+	err = jsn.Unmarshal([]byte(`{"created":"<2024-13-01>"}`), &copy)
+	ass.NotNil(t, err)
+
+	var moment = pri.MomentFromSource("<2024-01-02>")
+	var index = map[pri.Primitive[pri.MomentLike]]string{
+		{moment}: "New Year",
+	}
+	bytes, err = jsn.Marshal(index)
+	ass.Nil(t, err)
+	ass.Equal(t, `{"\u003c2024-01-02\u003e":"New Year"}`, string(bytes))
+}
+
 func TestResource(t *tes.T) {
 	var v = pri.Resource("https://craterdog.com/About.html")
 	ass.Equal(t, "https://craterdog.com/About.html", v.AsIntrinsic())
@@ -970,6 +2479,423 @@ func TestSymbolFromSource(t *tes.T) {
 
 // STRING
 
+func TestBinaryEncodings(t *tes.T) {
+	var sources = []string{
+		"~π",
+		"false",
+		"~P3D",
+		"~-PT1M",
+		"~-PT1.000000001S",
+		"<2024-05-01T12:00:00.123456789>",
+		"'a'",
+		"<2024-01-02>",
+		"<2024-01-02>..<2024-02-02T12>",
+		"R/<2024-01-01T09>/FREQ=WEEKLY;COUNT=4;BYDAY=MO,WE",
+		"<-10000-01-02>",
+		"-1.5",
+		"3+4i",
+		"-12.50d",
+		"-3/4",
+		"-123456789012345678901234567890n",
+		"∞",
+		"42%",
+		"p0.5",
+		"<https://craterdog.com/about>",
+		"'><'",
+		"'>\n    abcd1234\n<'",
+		"'>\n    :0001:ffff\n<'",
+		"$symbol",
+		"/ab/cd",
+		"\">\n    Hello\n    World!\n<\"",
+		`"\u0041"?`,
+		`"Hello World!"`,
+		"v1.2.300",
+		"[]",
+		"[1, 2i, -3]",
+		"[[1, 2], [3, 4+5i]]",
+	}
+	for _, source := range sources {
+		var primitive, err = pri.ParsePrimitive(source)
+		ass.Nil(t, err)
+		var bytes []byte
+		bytes, err = primitive.(enc.BinaryMarshaler).MarshalBinary()
+		ass.Nil(t, err)
+		ass.Equal(t, pri.FormatClass().Version(), bytes[0])
+		var copy any
+		copy, err = pri.DecodePrimitive(bytes)
+		ass.Nil(t, err)
+		ass.Equal(t, primitive, copy)
+	}
+
+	var tag = pri.TagWithSize(20)
+	var bytes, _ = tag.(enc.BinaryMarshaler).MarshalBinary()
+	ass.Equal(t, []byte{1, byte(pri.TagType)}, bytes[:2])
+	ass.Equal(t, 22, len(bytes))
+	bytes, _ = pri.Number(1.5).(enc.BinaryMarshaler).MarshalBinary()
+	ass.Equal(t, 10, len(bytes))
+	bytes, _ = pri.Number(1.5 + 2i).(enc.BinaryMarshaler).MarshalBinary()
+	ass.Equal(t, 18, len(bytes))
+	bytes, _ = pri.Duration(1000).(enc.BinaryMarshaler).MarshalBinary()
+	ass.Equal(t, []byte{1, byte(pri.DurationType), 0xe8, 0x07}, bytes)
+	bytes, _ = pri.Duration(-1000).(enc.BinaryMarshaler).MarshalBinary()
+	ass.Equal(t, []byte{1, byte(pri.DurationType), 0xe8, 0x07, 1}, bytes)
+	bytes, _ = pri.DurationWithNanoseconds(1000, 5).(enc.BinaryMarshaler).MarshalBinary()
+	ass.Equal(t, []byte{1, byte(pri.DurationType), 0xe8, 0x07, 0, 5}, bytes)
+	for _, duration := range []pri.DurationLike{
+		pri.Duration(0),
+		pri.Duration(-1),
+		pri.Duration(mat.MaxInt),
+		pri.Duration(mat.MinInt),
+		pri.DurationWithNanoseconds(0, -5),
+		pri.DurationWithNanoseconds(-7, -999999),
+	} {
+		bytes, _ = duration.(enc.BinaryMarshaler).MarshalBinary()
+		var decoded, err = pri.DecodePrimitive(bytes)
+		ass.NoError(t, err)
+		ass.Equal(t, duration.AsSource(), decoded.(pri.DurationLike).AsSource())
+	}
+	for _, payload := range [][]byte{
+		{0xe8, 0x07, 0},
+		{0xe8, 0x07, 2},
+		{0xe8, 0x07, 0, 0},
+		{0xe8, 0x07, 0, 0xc0, 0x84, 0x3d},
+		{0, 1},
+		{0xe8, 0x07, 0, 5, 5},
+	} {
+		var _, err = pri.DecodePrimitive(append([]byte{1, byte(pri.DurationType)}, payload...))
+		ass.Error(t, err)
+	}
+
+	var _, err = pri.DecodePrimitive([]byte{1})
+	ass.NotNil(t, err)
+	_, err = pri.DecodePrimitive([]byte{2, byte(pri.DurationType), 0})
+	ass.NotNil(t, err)
+	_, err = pri.DecodePrimitive([]byte{1, 0xff})
+	ass.NotNil(t, err)
+	_, err = pri.DecodePrimitive([]byte{1, byte(pri.AngleType), 0, 0})
+	ass.NotNil(t, err)
+	_, err = pri.DecodePrimitive([]byte{1, byte(pri.TagType), 1, 2, 3})
+	ass.NotNil(t, err)
+	_, err = pri.DecodePrimitive([]byte{1, byte(pri.ProbabilityType), 0x40, 0, 0, 0, 0, 0, 0, 0})
+	ass.NotNil(t, err)
+
+	type Record struct {
+		Created pri.Primitive[pri.MomentLike]
+		Tag     pri.Primitive[pri.TagLike]
+		Missing pri.Primitive[pri.VersionLike]
+	}
+	var record = Record{
+		Created: pri.Primitive[pri.MomentLike]{pri.MomentFromSource("<2024-01-02>")},
+		Tag:     pri.Primitive[pri.TagLike]{tag},
+	}
+	var buffer byt.Buffer
+	err = gob.NewEncoder(&buffer).Encode(record)
+	ass.Nil(t, err)
+	var copy Record
+	err = gob.NewDecoder(&buffer).Decode(&copy)
+	ass.Nil(t, err)
+	ass.Equal(t, record, copy)
+
+	var wrong pri.Primitive[pri.AngleLike]
+	bytes, _ = record.Created.MarshalBinary()
+	err = wrong.UnmarshalBinary(bytes)
+	ass.NotNil(t, err)
+}
+
+func TestCborEncodings(t *tes.T) {
+	type cborMarshaler interface {
+		MarshalCBOR() ([]byte, error)
+	}
+	var sources = []string{
+		"~π",
+		"false",
+		"~P3D",
+		"~-PT1M",
+		"~-PT1.000000001S",
+		"<2024-05-01T12:00:00.123456789>",
+		"'a'",
+		"<2024-01-02>",
+		"<2024-01-02>..<2024-02-02T12>",
+		"R/<2024-01-01T09>/FREQ=WEEKLY;COUNT=4;BYDAY=MO,WE",
+		"<2024-01-02T03:04:05.678>",
+		"<-10000-01-02>",
+		"-1.5",
+		"42",
+		"3+4i",
+		"-12.50d",
+		"-3/4",
+		"-123456789012345678901234567890n",
+		"∞",
+		"42%",
+		"p0.5",
+		"<https://craterdog.com/about>",
+		"'><'",
+		"'>\n    abcd1234\n<'",
+		"'>\n    :0001:ffff\n<'",
+		"identifier",
+		"/ab/cd",
+		"\">\n    Hello\n    World!\n<\"",
+		`"[a-z]+"?`,
+		`"Hello World!"`,
+		"$symbol",
+		pri.TagWithSize(10).AsSource(),
+		"v1.2.300",
+		"[1, 2i, -3]",
+		"[[1, 2], [3, 4]]",
+	}
+	for _, source := range sources {
+		var primitive, err = pri.ParsePrimitive(source)
+		ass.Nil(t, err)
+		var bytes []byte
+		bytes, err = primitive.(cborMarshaler).MarshalCBOR()
+		ass.Nil(t, err)
+		var copy any
+		copy, err = pri.DecodeCbor(bytes)
+		ass.Nil(t, err)
+		ass.Equal(t, source, copy.(interface{ AsSource() string }).AsSource())
+	}
+
+	var bytes, _ = pri.MomentFromSource("<1970-01-01T00:00:01>").(cborMarshaler).MarshalCBOR()
+	ass.Equal(t, []byte{0xc1, 0x01}, bytes)
+	bytes, _ = pri.ResourceFromSource("<https://a.b>").(cborMarshaler).MarshalCBOR()
+	ass.Equal(t, []byte{0xd8, 0x20, 0x6b}, bytes[:3])
+	bytes, _ = pri.Binary([]byte{1, 2}).(cborMarshaler).MarshalCBOR()
+	ass.Equal(t, []byte{0x42, 1, 2}, bytes)
+	bytes, _ = pri.Boolean(true).(cborMarshaler).MarshalCBOR()
+	ass.Equal(t, []byte{0xf5}, bytes)
+	bytes, _ = pri.Number(1.5).(cborMarshaler).MarshalCBOR()
+	ass.Equal(t, []byte{0xfa, 0x3f, 0xc0, 0, 0}, bytes)
+	bytes, _ = pri.Number(1 + 2i).(cborMarshaler).MarshalCBOR()
+	ass.Equal(t, []byte{0xda, 0x63, 0x64, 0x67, 0x06, 0x82}, bytes[:6])
+	bytes, _ = pri.VersionFromSource("v1.2").(cborMarshaler).MarshalCBOR()
+	ass.Equal(t, []byte{0xda, 0x63, 0x64, 0x67, 0x1a, 0x82, 0x01, 0x02}, bytes)
+	bytes, _ = pri.VectorFromSource("[1, 2i]").(cborMarshaler).MarshalCBOR()
+	ass.Equal(t, []byte{0xda, 0x63, 0x64, 0x67, 0x1c, 0x82, 0x82}, bytes[:7])
+
+	var copy, err = pri.DecodeCbor([]byte{0x01})
+	ass.Nil(t, err)
+	ass.Equal(t, "1", copy.(interface{ AsSource() string }).AsSource())
+	copy, err = pri.DecodeCbor([]byte{0xf9, 0x3c, 0x00})
+	ass.Nil(t, err)
+	ass.Equal(t, "1", copy.(interface{ AsSource() string }).AsSource())
+	_, err = pri.DecodeCbor([]byte{})
+	ass.NotNil(t, err)
+	_, err = pri.DecodeCbor([]byte{0x01, 0x02})
+	ass.NotNil(t, err)
+	_, err = pri.DecodeCbor([]byte{0xa0})
+	ass.NotNil(t, err)
+	_, err = pri.DecodeCbor([]byte{0xc1, 0x61, 0x78})
+	ass.NotNil(t, err)
+	_, err = pri.DecodeCbor([]byte{0xda, 0x63, 0x64, 0x67, 0x08, 0x02})
+	ass.NotNil(t, err)
+	_, err = pri.DecodeCbor([]byte{0x5a, 0xff, 0xff, 0xff, 0xff})
+	ass.NotNil(t, err)
+
+	var wrapper = pri.Primitive[pri.TagLike]{pri.TagWithSize(8)}
+	bytes, err = wrapper.MarshalCBOR()
+	ass.Nil(t, err)
+	var tag pri.Primitive[pri.TagLike]
+	err = tag.UnmarshalCBOR(bytes)
+	ass.Nil(t, err)
+	ass.Equal(t, wrapper, tag)
+	var missing pri.Primitive[pri.TagLike]
+	bytes, _ = missing.MarshalCBOR()
+	ass.Equal(t, []byte{0xf6}, bytes)
+	err = tag.UnmarshalCBOR(bytes)
+	ass.Nil(t, err)
+	ass.Nil(t, tag.Value)
+	var wrong pri.Primitive[pri.AngleLike]
+	bytes, _ = wrapper.MarshalCBOR()
+	err = wrong.UnmarshalCBOR(bytes)
+	ass.NotNil(t, err)
+}
+
+// The following types implement an in-memory database driver stub that stores
+// its rows the way SQLite does (i.e. booleans are stored as integers).
+
+type sqlConnector struct {
+	rows [][]drv.Value
+}
+
+func (c *sqlConnector) Connect(context ctx.Context) (drv.Conn, error) {
+	return sqlConnection{c}, nil
+}
+
+func (c *sqlConnector) Driver() drv.Driver {
+	return nil
+}
+
+type sqlConnection struct {
+	connector *sqlConnector
+}
+
+func (c sqlConnection) Prepare(query string) (drv.Stmt, error) {
+	return sqlStatement{c.connector, query}, nil
+}
+
+func (c sqlConnection) Close() error {
+	return nil
+}
+
+func (c sqlConnection) Begin() (drv.Tx, error) {
+	return nil, drv.ErrSkip
+}
+
+type sqlStatement struct {
+	connector *sqlConnector
+	query     string
+}
+
+func (s sqlStatement) Close() error {
+	return nil
+}
+
+func (s sqlStatement) NumInput() int {
+	return -1
+}
+
+func (s sqlStatement) Exec(arguments []drv.Value) (drv.Result, error) {
+	switch s.query {
+	case "DELETE":
+		s.connector.rows = nil
+	case "INSERT":
+		var row = make([]drv.Value, len(arguments))
+		for index, argument := range arguments {
+			if boolean, ok := argument.(bool); ok {
+				argument = int64(0)
+				if boolean {
+					argument = int64(1)
+				}
+			}
+			row[index] = argument
+		}
+		s.connector.rows = append(s.connector.rows, row)
+	}
+	return drv.RowsAffected(1), nil
+}
+
+func (s sqlStatement) Query(arguments []drv.Value) (drv.Rows, error) {
+	return &sqlRows{rows: s.connector.rows}, nil
+}
+
+type sqlRows struct {
+	rows  [][]drv.Value
+	index int
+}
+
+func (r *sqlRows) Columns() []string {
+	return []string{"value"}
+}
+
+func (r *sqlRows) Close() error {
+	return nil
+}
+
+func (r *sqlRows) Next(destination []drv.Value) error {
+	if r.index == len(r.rows) {
+		return io.EOF
+	}
+	copy(destination, r.rows[r.index])
+	r.index++
+	return nil
+}
+
+func TestDatabaseValues(t *tes.T) {
+	var connector = &sqlConnector{}
+	var database = sql.OpenDB(connector)
+	defer database.Close()
+	var sources = []string{
+		"~π",
+		"false",
+		"true",
+		"~P3D",
+		"~-PT1M",
+		"~-PT1.000000001S",
+		"<2024-05-01T12:00:00.123456789>",
+		"'a'",
+		"<2024-01-02>",
+		"<2024-01-02>..<2024-02-02T12>",
+		"R/<2024-01-01T09>/FREQ=WEEKLY;COUNT=4;BYDAY=MO,WE",
+		"<2024-01-02T03:04:05.678>",
+		"-1.5",
+		"3+4i",
+		"-12.50d",
+		"-3/4",
+		"-123456789012345678901234567890n",
+		"42%",
+		"p0.5",
+		"<https://craterdog.com/about>",
+		"'>\n    abcd1234\n<'",
+		"'>\n    :0001:ffff\n<'",
+		"identifier",
+		"/ab/cd",
+		"\">\n    Hello\n    World!\n<\"",
+		`"[a-z]+"?`,
+		`"Hello World!"`,
+		"$symbol",
+		pri.TagWithSize(10).AsSource(),
+		"v1.2.300",
+		"[1, 2i, -3]",
+		"[[1, 2], [3, 4]]",
+	}
+	for _, source := range sources {
+		var primitive, err = pri.ParsePrimitive(source)
+		ass.Nil(t, err)
+		_, err = database.Exec("DELETE")
+		ass.Nil(t, err)
+		_, err = database.Exec("INSERT", primitive)
+		ass.Nil(t, err)
+		var pointer = ref.New(ref.TypeOf(primitive))
+		err = database.QueryRow("SELECT").Scan(pointer.Interface())
+		ass.Nil(t, err)
+		var copy = pointer.Elem().Interface()
+		ass.Equal(t, source, copy.(interface{ AsSource() string }).AsSource())
+	}
+
+	var primitives = []any{
+		pri.MomentFromSource("<2024-01-02>"),
+		pri.Duration(1000),
+		pri.Boolean(true),
+		pri.Binary([]byte{1, 2}),
+		pri.Number(3 + 4i),
+		pri.VersionFromSource("v1.2"),
+	}
+	var values = []drv.Value{
+		tim.Date(2024, 1, 2, 0, 0, 0, 0, tim.UTC),
+		int64(1000),
+		true,
+		[]byte{1, 2},
+		"3+4i",
+		"v1.2",
+	}
+	for index, primitive := range primitives {
+		var value, err = primitive.(drv.Valuer).Value()
+		ass.Nil(t, err)
+		ass.Equal(t, values[index], value)
+	}
+	var tag = pri.TagWithSize(8)
+	var value, _ = tag.(drv.Valuer).Value()
+	ass.Equal(t, tag.AsIntrinsic(), value)
+
+	var moment = pri.MomentFromSource("<2024-01-02>")
+	var scanner = ref.New(ref.TypeOf(moment)).Interface().(sql.Scanner)
+	ass.Nil(t, scanner.Scan("<2024-01-02>"))
+	ass.NotNil(t, scanner.Scan(int64(42)))
+	ass.NotNil(t, scanner.Scan(nil))
+	var duration = pri.Duration(0)
+	scanner = ref.New(ref.TypeOf(duration)).Interface().(sql.Scanner)
+	ass.Nil(t, scanner.Scan(int64(-1)))
+	var boolean = pri.Boolean(false)
+	scanner = ref.New(ref.TypeOf(boolean)).Interface().(sql.Scanner)
+	ass.NotNil(t, scanner.Scan(int64(2)))
+	scanner = ref.New(ref.TypeOf(tag)).Interface().(sql.Scanner)
+	ass.NotNil(t, scanner.Scan([]byte{1, 2, 3}))
+	var name = pri.NameFromSource("/ab/cd")
+	scanner = ref.New(ref.TypeOf(name)).Interface().(sql.Scanner)
+	ass.NotNil(t, scanner.Scan("ab/cd"))
+	ass.NotNil(t, scanner.Scan(1.5))
+}
+
 func TestEmptyBinary(t *tes.T) {
 	var binary = `'><'`
 	var v = pri.BinaryFromSource(binary)
@@ -1032,6 +2958,63 @@ func TestBytecode(t *tes.T) {
 	ass.Equal(t, v.AsArray(), pri.Bytecode(v.AsArray()).AsArray())
 }
 
+func TestMatrices(t *tes.T) {
+	var class = pri.MatrixClass()
+	var m1 = pri.MatrixFromSource("[[1, 2], [3, 4]]")
+	ass.Equal(t, "[[1, 2], [3, 4]]", m1.AsSource())
+	ass.Equal(t, [][]complex128{{1, 2}, {3, 4}}, m1.AsIntrinsic())
+	ass.Equal(t, uint(2), m1.GetRows())
+	ass.Equal(t, uint(2), m1.GetColumns())
+	ass.Equal(t, pri.Number(3), m1.GetElement(-1, 1))
+	ass.Equal(t, "[3, 4]", m1.GetRow(2).AsSource())
+	ass.Equal(t, "[2, 4]", m1.GetColumn(-1).AsSource())
+	ass.Equal(t, m1, pri.MatrixFromSequence(m1))
+	ass.Equal(t, "[[1, 3], [2, 4]]", class.Transposed(m1).AsSource())
+	ass.Equal(t, "[[-1, -2], [-3, -4]]", class.Inverse(m1).AsSource())
+	ass.Equal(t, "[[2, 4], [6, 8]]", class.Sum(m1, m1).AsSource())
+	ass.Equal(t, "[[0, 0], [0, 0]]", class.Difference(m1, m1).AsSource())
+	ass.Equal(t, "[[1, 4], [9, 16]]", class.Product(m1, m1).AsSource())
+	ass.Equal(t, "[[1, 1], [1, 1]]", class.Quotient(m1, m1).AsSource())
+	ass.Equal(t, "[[1i, 2i], [3i, 4i]]", class.Scaled(m1, pri.Number(1i)).AsSource())
+	ass.Equal(t, "[[7, 10], [15, 22]]", class.MatrixProduct(m1, m1).AsSource())
+	ass.Equal(t, m1, class.MatrixProduct(m1, class.Identity(2)))
+	ass.Equal(t, "[5, 11]", class.Transformed(m1, pri.VectorFromSource("[1, 2]")).AsSource())
+	ass.Equal(t, pri.Number(-2), class.Determinant(m1))
+
+	var m2 = pri.Matrix([][]complex128{{4, 7}, {2, 6}})
+	ass.Equal(t, "[[0.6, -0.7], [-0.2, 0.4]]", class.Reciprocal(m2).AsSource())
+	var m3 = pri.MatrixFromSource("[[2, 0, 0, 0], [0, 4, 0, 0], [0, 0, 5, 0], [1, 0, 0, 1]]")
+	ass.Equal(t, pri.Number(40), class.Determinant(m3))
+	ass.Equal(
+		t,
+		"[[0.5, 0, 0, 0], [0, 0.25, 0, 0], [0, 0, 0.2, 0], [-0.5, 0, 0, 1]]",
+		class.Reciprocal(m3).AsSource(),
+	)
+	ass.Equal(t, class.Identity(4), class.MatrixProduct(m3, class.Reciprocal(m3)))
+	ass.Equal(t, pri.Number(-1), class.Determinant(
+		pri.MatrixFromSource("[[0, 1, 0], [1, 0, 0], [0, 0, 1]]"),
+	))
+
+	var quarter = pri.Angle(mat.Pi / 2)
+	var rotation = pri.Rotation(quarter)
+	ass.Equal(t, "[[0, -1], [1, 0]]", rotation.AsSource())
+	ass.Equal(t, "[0, 1]", class.Transformed(rotation, pri.VectorFromSource("[1, 0]")).AsSource())
+	rotation = pri.RotationAbout(pri.VectorFromSource("[0, 0, 2]"), quarter)
+	ass.Equal(t, "[[0, -1, 0], [1, 0, 0], [0, 0, 1]]", rotation.AsSource())
+
+	var _, err = pri.ParseMatrix("[[1, 2], [3]]")
+	ass.NotNil(t, err)
+	ass.Equal(t, uint(9), err.(pri.ParseErrorLike).GetOffset())
+	_, err = pri.ParseMatrix("[[]]")
+	ass.NotNil(t, err)
+	_, err = pri.ParseMatrix("[]")
+	ass.NotNil(t, err)
+	defer func() {
+		ass.Equal(t, "The matrix must be square: 1x2", recover())
+	}()
+	class.Determinant(pri.MatrixFromSource("[[1, 2]]"))
+}
+
 func TestName(t *tes.T) {
 	var v1 = pri.NameFromSource("/bali-nebula/types/abstractions/5String")
 	ass.Equal(t, "/bali-nebula/types/abstractions/5String", v1.AsSource())
@@ -1210,6 +3193,43 @@ func TestStringTags(t *tes.T) {
 	}
 }
 
+func TestVectors(t *tes.T) {
+	var class = pri.VectorClass()
+	var v1 = pri.VectorFromSource("[1, 2i, -3]")
+	ass.Equal(t, "[1, 2i, -3]", v1.AsSource())
+	ass.Equal(t, []complex128{1, 2i, -3}, v1.AsIntrinsic())
+	ass.False(t, v1.IsEmpty())
+	ass.Equal(t, uint(3), v1.GetSize())
+	ass.Equal(t, pri.Number(-3), v1.GetValue(-1))
+	ass.Equal(t, 2, v1.GetIndex(pri.Number(2i)))
+	ass.Equal(t, "[2i, -3]", pri.VectorFromSequence(v1.GetValues(2, 3)).AsSource())
+	ass.True(t, pri.Vector(nil).IsEmpty())
+	ass.Equal(t, "[]", pri.Vector(nil).AsSource())
+
+	var v2 = pri.Vector([]complex128{2, 1, 1 + 1i})
+	ass.Equal(t, "[-1, -2i, 3]", class.Inverse(v1).AsSource())
+	ass.Equal(t, "[3, 1+2i, -2+1i]", class.Sum(v1, v2).AsSource())
+	ass.Equal(t, "[-1, -1+2i, -4-1i]", class.Difference(v1, v2).AsSource())
+	ass.Equal(t, "[2, 2i, -3-3i]", class.Product(v1, v2).AsSource())
+	ass.Equal(t, "[0.5, 2i, -1.5+1.5i]", class.Quotient(v1, v2).AsSource())
+	ass.Equal(t, "[2, 4i, -6]", class.Scaled(v1, pri.Number(2)).AsSource())
+	ass.Equal(t, pri.Number(-1-1i), class.DotProduct(v1, v2))
+
+	var x = pri.VectorFromSource("[1, 0, 0]")
+	var y = pri.VectorFromSource("[0, 1, 0]")
+	ass.Equal(t, "[0, 0, 1]", class.CrossProduct(x, y).AsSource())
+	ass.Equal(t, "[0, 0, -1]", class.CrossProduct(y, x).AsSource())
+
+	var _, err = pri.ParseVector("[1, 2")
+	ass.NotNil(t, err)
+	_, err = pri.ParseVector("[1,2]")
+	ass.NotNil(t, err)
+	defer func() {
+		ass.Equal(t, "The vectors must be the same size: 3 and 2", recover())
+	}()
+	class.Sum(v1, pri.Vector([]complex128{1, 2}))
+}
+
 func TestVersion(t *tes.T) {
 	var v1 = pri.VersionFromSource("v1.2.3")
 	ass.Equal(t, "v1.2.3", v1.AsSource())
@@ -13,7 +13,11 @@
 package sequences
 
 import (
+	drv "database/sql/driver"
+	jsn "encoding/json"
 	fmt "fmt"
+	ele "github.com/craterdog/go-essential-primitives/v8/elements"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	reg "regexp"
 	sts "strings"
@@ -58,15 +62,31 @@ func (c *binaryClass_) BinaryFromSequence(
 func (c *binaryClass_) BinaryFromSource(
 	source string,
 ) BinaryLike {
-	var matches = c.matcher_.FindStringSubmatch(source)
+	var binary, err = c.ParseBinary(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return binary
+}
+
+func (c *binaryClass_) ParseBinary(
+	source string,
+) (
+	binary BinaryLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
 	if uti.IsUndefined(matches) {
-		var message = fmt.Sprintf(
-			"An illegal string was passed to the binary constructor method: %s",
+		err = ele.ParseErrorClass().ParseErrorFromMatcher(
+			"binary",
+			c.matcher_,
+			c.productions_,
 			source,
 		)
-		panic(message)
+		return
 	}
-	return binary_(source)
+	binary = binary_(source)
+	return
 }
 
 // Constant Methods
@@ -242,6 +262,132 @@ func (v binary_) String() string {
 	return v.AsSource()
 }
 
+func (v binary_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *binary_) UnmarshalText(
+	text []byte,
+) error {
+	var binary, err = binaryClass().ParseBinary(string(text))
+	if err != nil {
+		return err
+	}
+	*v = binary.(binary_)
+	return nil
+}
+
+func (v binary_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *binary_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(source))
+}
+
+func (v binary_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsIntrinsic())
+}
+
+func (v *binary_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	var intrinsic []byte
+	var err = jsn.Unmarshal(bytes, &intrinsic)
+	if err != nil {
+		return err
+	}
+	*v = binaryClass().Binary(intrinsic).(binary_)
+	return nil
+}
+
+func (v binary_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = ele.FormatClass().Header(BinaryType)
+	bytes = append(bytes, v.AsIntrinsic()...)
+	return
+}
+
+func (v *binary_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = ele.FormatClass().Payload(BinaryType, bytes)
+	if err != nil {
+		return err
+	}
+	*v = binaryClass().Binary(payload).(binary_)
+	return nil
+}
+
+func (v binary_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	return ele.CborClass().Encode(0, v.AsIntrinsic())
+}
+
+func (v *binary_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = ele.CborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != 0 {
+		return fmt.Errorf("The CBOR data item is not a binary: %x", bytes)
+	}
+	var intrinsic, ok = value.([]byte)
+	if !ok {
+		return fmt.Errorf("The CBOR data item is not a binary: %x", bytes)
+	}
+	*v = binaryClass().Binary(intrinsic).(binary_)
+	return nil
+}
+
+func (v binary_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsIntrinsic()
+	return
+}
+
+func (v *binary_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case []byte:
+		*v = binaryClass().Binary(actual).(binary_)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a binary: %v",
+			source,
+		)
+	}
+}
+
 // Private Methods
 
 // NOTE:
@@ -267,7 +413,8 @@ type binary_ string // This type must support the "comparable" type contraint.
 
 type binaryClass_ struct {
 	// Declare the class constants.
-	matcher_ *reg.Regexp
+	matcher_     *reg.Regexp
+	productions_ map[int]string
 }
 
 // Class Reference
@@ -282,4 +429,7 @@ var binaryClassReference_ = &binaryClass_{
 		"^'>(" + eol_ + "((?:" + space_ + ")*(?:" + base64_ + "){2,60}" +
 			eol_ + ")+(?:" + space_ + ")*)?<'",
 	),
+	productions_: map[int]string{
+		2: "line of base 64 characters",
+	},
 }
@@ -13,7 +13,12 @@
 package sequences
 
 import (
+	drv "database/sql/driver"
+	bin "encoding/binary"
+	jsn "encoding/json"
 	fmt "fmt"
+	ele "github.com/craterdog/go-essential-primitives/v8/elements"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	mat "math"
 	reg "regexp"
@@ -56,15 +61,31 @@ func (c *bytecodeClass_) BytecodeFromSequence(
 func (c *bytecodeClass_) BytecodeFromSource(
 	source string,
 ) BytecodeLike {
-	var matches = c.matcher_.FindStringSubmatch(source)
+	var bytecode, err = c.ParseBytecode(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return bytecode
+}
+
+func (c *bytecodeClass_) ParseBytecode(
+	source string,
+) (
+	bytecode BytecodeLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
 	if uti.IsUndefined(matches) {
-		var message = fmt.Sprintf(
-			"An illegal string was passed to the bytecode constructor method: %s",
+		err = ele.ParseErrorClass().ParseErrorFromMatcher(
+			"bytecode",
+			c.matcher_,
+			c.productions_,
 			source,
 		)
-		panic(message)
+		return
 	}
-	return bytecode_(source)
+	bytecode = bytecode_(source)
+	return
 }
 
 // Constant Methods
@@ -124,6 +145,149 @@ func (v bytecode_) String() string {
 	return v.AsSource()
 }
 
+func (v bytecode_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *bytecode_) UnmarshalText(
+	text []byte,
+) error {
+	var bytecode, err = bytecodeClass().ParseBytecode(string(text))
+	if err != nil {
+		return err
+	}
+	*v = bytecode.(bytecode_)
+	return nil
+}
+
+func (v bytecode_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *bytecode_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(source))
+}
+
+func (v bytecode_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsIntrinsic())
+}
+
+func (v *bytecode_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	var instructions []uint16
+	var err = jsn.Unmarshal(bytes, &instructions)
+	if err != nil {
+		return err
+	}
+	*v = bytecodeClass().Bytecode(instructions).(bytecode_)
+	return nil
+}
+
+func (v bytecode_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = ele.FormatClass().Header(BytecodeType)
+	for _, instruction := range v.AsIntrinsic() {
+		bytes = bin.BigEndian.AppendUint16(bytes, instruction)
+	}
+	return
+}
+
+func (v *bytecode_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = ele.FormatClass().Payload(BytecodeType, bytes)
+	if err != nil {
+		return err
+	}
+	if len(payload)%2 != 0 {
+		return fmt.Errorf("The binary payload is not bytecode: %x", payload)
+	}
+	var instructions = make([]uint16, len(payload)/2)
+	for index := range instructions {
+		instructions[index] = bin.BigEndian.Uint16(payload[2*index:])
+	}
+	*v = bytecodeClass().Bytecode(instructions).(bytecode_)
+	return nil
+}
+
+func (v bytecode_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	var instructions []any
+	for _, instruction := range v.AsIntrinsic() {
+		instructions = append(instructions, uint64(instruction))
+	}
+	return ele.CborClass().Encode(bytecodeClass().cborTag_, instructions)
+}
+
+func (v *bytecode_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = ele.CborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != bytecodeClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not bytecode: %x", bytes)
+	}
+	var items, _ = value.([]any)
+	var instructions = make([]uint16, len(items))
+	for index, item := range items {
+		var instruction, ok = item.(uint64)
+		if !ok || instruction > mat.MaxUint16 {
+			return fmt.Errorf("The CBOR data item is not bytecode: %x", bytes)
+		}
+		instructions[index] = uint16(instruction)
+	}
+	*v = bytecodeClass().Bytecode(instructions).(bytecode_)
+	return nil
+}
+
+func (v bytecode_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *bytecode_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into bytecode: %v",
+			source,
+		)
+	}
+}
+
 // Private Methods
 
 // NOTE:
@@ -145,7 +309,9 @@ type bytecode_ string // This type must support the "comparable" type contraint.
 
 type bytecodeClass_ struct {
 	// Declare the class constants.
-	matcher_ *reg.Regexp
+	matcher_     *reg.Regexp
+	productions_ map[int]string
+	cborTag_     uint64
 }
 
 // Class Reference
@@ -160,4 +326,8 @@ var bytecodeClassReference_ = &bytecodeClass_{
 		"^'>" + eol_ + "((?:" + space_ + ")*(?:" + instruction_ + "){1,12}" +
 			eol_ + ")+(?:" + space_ + ")*<'",
 	),
+	productions_: map[int]string{
+		1: "line of instructions",
+	},
+	cborTag_: 0x63646712,
 }
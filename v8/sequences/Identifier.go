@@ -13,7 +13,11 @@
 package sequences
 
 import (
+	drv "database/sql/driver"
+	jsn "encoding/json"
 	fmt "fmt"
+	ele "github.com/craterdog/go-essential-primitives/v8/elements"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	reg "regexp"
 	sli "slices"
@@ -44,15 +48,31 @@ func (c *identifierClass_) IdentifierFromSequence(
 func (c *identifierClass_) IdentifierFromSource(
 	source string,
 ) IdentifierLike {
-	var matches = c.matcher_.FindStringSubmatch(source)
+	var identifier, err = c.ParseIdentifier(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return identifier
+}
+
+func (c *identifierClass_) ParseIdentifier(
+	source string,
+) (
+	identifier IdentifierLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
 	if uti.IsUndefined(matches) {
-		var message = fmt.Sprintf(
-			"An illegal string was passed to the identifier constructor method: %s",
+		err = ele.ParseErrorClass().ParseErrorFromMatcher(
+			"identifier",
+			c.matcher_,
+			c.productions_,
 			source,
 		)
-		panic(message)
+		return
 	}
-	return identifier_(source)
+	identifier = identifier_(source)
+	return
 }
 
 // Constant Methods
@@ -190,6 +210,125 @@ func (v identifier_) String() string {
 	return v.AsSource()
 }
 
+func (v identifier_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *identifier_) UnmarshalText(
+	text []byte,
+) error {
+	var identifier, err = identifierClass().ParseIdentifier(string(text))
+	if err != nil {
+		return err
+	}
+	*v = identifier.(identifier_)
+	return nil
+}
+
+func (v identifier_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *identifier_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(source))
+}
+
+func (v identifier_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(string(v.AsIntrinsic()))
+}
+
+func (v *identifier_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	var text string
+	var err = jsn.Unmarshal(bytes, &text)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(text))
+}
+
+func (v identifier_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = ele.FormatClass().Header(IdentifierType)
+	bytes = append(bytes, v.AsSource()...)
+	return
+}
+
+func (v *identifier_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = ele.FormatClass().Payload(IdentifierType, bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(payload)
+}
+
+func (v identifier_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	return ele.CborClass().Encode(identifierClass().cborTag_, string(v.AsIntrinsic()))
+}
+
+func (v *identifier_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = ele.CborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != identifierClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not an identifier: %x", bytes)
+	}
+	var text, _ = value.(string)
+	return v.UnmarshalText([]byte(text))
+}
+
+func (v identifier_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *identifier_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into an identifier: %v",
+			source,
+		)
+	}
+}
+
 // Private Methods
 
 // Instance Structure
@@ -200,8 +339,10 @@ type identifier_ string
 
 type identifierClass_ struct {
 	// Declare the class constants.
-	matcher_   *reg.Regexp
-	undefined_ IdentifierLike
+	matcher_     *reg.Regexp
+	productions_ map[int]string
+	undefined_   IdentifierLike
+	cborTag_     uint64
 }
 
 // Class Reference
@@ -215,5 +356,9 @@ var identifierClassReference_ = &identifierClass_{
 	matcher_: reg.MustCompile(
 		"^((?:" + letter_ + ")((-)?(?:" + letter_ + "|" + digit_ + "))*)",
 	),
+	productions_: map[int]string{
+		1: "identifier",
+	},
 	undefined_: identifier_(""),
+	cborTag_:   0x63646713,
 }
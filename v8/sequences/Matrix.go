@@ -0,0 +1,840 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package sequences
+
+import (
+	drv "database/sql/driver"
+	bin "encoding/binary"
+	jsn "encoding/json"
+	fmt "fmt"
+	ele "github.com/craterdog/go-essential-primitives/v8/elements"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	mat "math"
+	cmp "math/cmplx"
+	reg "regexp"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func MatrixClass() MatrixClassLike {
+	return matrixClass()
+}
+
+// Constructor Methods
+
+func (c *matrixClass_) Matrix(
+	values [][]complex128,
+) MatrixLike {
+	return c.matrixFromComplex(values)
+}
+
+func (c *matrixClass_) MatrixFromSequence(
+	sequence Sequential[VectorLike],
+) MatrixLike {
+	var values = make([][]complex128, 0, sequence.GetSize())
+	var iterator = sequence.GetIterator()
+	for iterator.HasNext() {
+		values = append(values, iterator.GetNext().AsIntrinsic())
+	}
+	return c.matrixFromComplex(values)
+}
+
+func (c *matrixClass_) Identity(
+	size uint,
+) MatrixLike {
+	var values = make([][]complex128, size)
+	for row := range values {
+		values[row] = make([]complex128, size)
+		values[row][row] = 1
+	}
+	return c.matrixFromComplex(values)
+}
+
+func (c *matrixClass_) Rotation(
+	angle ele.AngleLike,
+) MatrixLike {
+	var cosine = complex(ele.AngleClass().Cosine(angle), 0)
+	var sine = complex(ele.AngleClass().Sine(angle), 0)
+	return c.matrixFromComplex([][]complex128{
+		{cosine, -sine},
+		{sine, cosine},
+	})
+}
+
+func (c *matrixClass_) RotationAbout(
+	axis VectorLike,
+	angle ele.AngleLike,
+) MatrixLike {
+	var values = axis.AsIntrinsic()
+	var length float64
+	for _, value := range values {
+		length += real(value) * real(value)
+	}
+	length = mat.Sqrt(length)
+	if len(values) != 3 || length == 0 {
+		var message = fmt.Sprintf(
+			"A rotation axis must be a non-zero vector of size 3: %v",
+			axis,
+		)
+		panic(message)
+	}
+	for _, value := range values {
+		if imag(value) != 0 {
+			var message = fmt.Sprintf(
+				"A rotation axis must have real elements: %v",
+				axis,
+			)
+			panic(message)
+		}
+	}
+	var x = real(values[0]) / length
+	var y = real(values[1]) / length
+	var z = real(values[2]) / length
+	var cosine = ele.AngleClass().Cosine(angle)
+	var sine = ele.AngleClass().Sine(angle)
+	var versine = 1 - cosine
+	return c.matrixFromComplex([][]complex128{
+		{
+			complex(cosine+x*x*versine, 0),
+			complex(x*y*versine-z*sine, 0),
+			complex(x*z*versine+y*sine, 0),
+		},
+		{
+			complex(y*x*versine+z*sine, 0),
+			complex(cosine+y*y*versine, 0),
+			complex(y*z*versine-x*sine, 0),
+		},
+		{
+			complex(z*x*versine-y*sine, 0),
+			complex(z*y*versine+x*sine, 0),
+			complex(cosine+z*z*versine, 0),
+		},
+	})
+}
+
+func (c *matrixClass_) MatrixFromSource(
+	source string,
+) MatrixLike {
+	var matrix, err = c.ParseMatrix(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return matrix
+}
+
+func (c *matrixClass_) ParseMatrix(
+	source string,
+) (
+	matrix MatrixLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
+	if uti.IsUndefined(matches) {
+		err = ele.ParseErrorClass().ParseErrorFromMatcher(
+			"matrix",
+			c.matcher_,
+			c.productions_,
+			source,
+		)
+		return
+	}
+	var rows = sts.Split(matches[0][1:len(matches[0])-1], ", [")
+	var values = make([][]complex128, len(rows))
+	var offset = 1
+	for index, row := range rows {
+		if index > 0 {
+			row = "[" + row
+		}
+		values[index] = vectorClass().complexFromSource(row)
+		var expected string
+		switch {
+		case len(values[index]) == 0:
+			expected = "a row with at least one element"
+		case len(values[index]) != len(values[0]):
+			expected = fmt.Sprintf("a row of %v elements", len(values[0]))
+		}
+		if len(expected) > 0 {
+			err = ele.ParseErrorClass().ParseError(
+				"matrix",
+				source,
+				uint(offset),
+				expected,
+			)
+			return
+		}
+		offset += len(row) + 2
+	}
+	matrix = c.matrixFromComplex(values)
+	return
+}
+
+// Constant Methods
+
+// Function Methods
+
+func (c *matrixClass_) Inverse(
+	matrix MatrixLike,
+) MatrixLike {
+	var vectors = vectorClass()
+	var rows = matrix.AsArray()
+	for index, row := range rows {
+		rows[index] = vectors.Inverse(row)
+	}
+	return c.matrixFromVectors(rows)
+}
+
+func (c *matrixClass_) Sum(
+	first MatrixLike,
+	second MatrixLike,
+) MatrixLike {
+	return c.elementwise(first, second, vectorClass().Sum)
+}
+
+func (c *matrixClass_) Difference(
+	first MatrixLike,
+	second MatrixLike,
+) MatrixLike {
+	return c.elementwise(first, second, vectorClass().Difference)
+}
+
+func (c *matrixClass_) Scaled(
+	matrix MatrixLike,
+	factor ele.NumberLike,
+) MatrixLike {
+	var vectors = vectorClass()
+	var rows = matrix.AsArray()
+	for index, row := range rows {
+		rows[index] = vectors.Scaled(row, factor)
+	}
+	return c.matrixFromVectors(rows)
+}
+
+func (c *matrixClass_) Product(
+	first MatrixLike,
+	second MatrixLike,
+) MatrixLike {
+	return c.elementwise(first, second, vectorClass().Product)
+}
+
+func (c *matrixClass_) Quotient(
+	first MatrixLike,
+	second MatrixLike,
+) MatrixLike {
+	return c.elementwise(first, second, vectorClass().Quotient)
+}
+
+func (c *matrixClass_) MatrixProduct(
+	first MatrixLike,
+	second MatrixLike,
+) MatrixLike {
+	if first.GetColumns() != second.GetRows() {
+		var message = fmt.Sprintf(
+			"The columns of the first matrix must match the rows of the second: %v and %v",
+			first.GetColumns(),
+			second.GetRows(),
+		)
+		panic(message)
+	}
+	var a = first.AsIntrinsic()
+	var b = second.AsIntrinsic()
+	var values = make([][]complex128, len(a))
+	for row := range values {
+		values[row] = make([]complex128, len(b[0]))
+		for column := range values[row] {
+			for index := range b {
+				values[row][column] += a[row][index] * b[index][column]
+			}
+		}
+	}
+	return c.matrixFromComplex(values)
+}
+
+func (c *matrixClass_) Transformed(
+	matrix MatrixLike,
+	vector VectorLike,
+) VectorLike {
+	if matrix.GetColumns() != vector.GetSize() {
+		var message = fmt.Sprintf(
+			"The columns of the matrix must match the size of the vector: %v and %v",
+			matrix.GetColumns(),
+			vector.GetSize(),
+		)
+		panic(message)
+	}
+	var vectors = vectorClass()
+	var rows = matrix.AsArray()
+	var values = make([]complex128, len(rows))
+	for index, row := range rows {
+		values[index] = vectors.DotProduct(row, vector).AsIntrinsic()
+	}
+	return vectors.vectorFromComplex(values)
+}
+
+func (c *matrixClass_) Transposed(
+	matrix MatrixLike,
+) MatrixLike {
+	var values = matrix.AsIntrinsic()
+	var transposed = make([][]complex128, len(values[0]))
+	for column := range transposed {
+		transposed[column] = make([]complex128, len(values))
+		for row := range values {
+			transposed[column][row] = values[row][column]
+		}
+	}
+	return c.matrixFromComplex(transposed)
+}
+
+func (c *matrixClass_) Determinant(
+	matrix MatrixLike,
+) ele.NumberLike {
+	c.validateSquare(matrix)
+	var determinant = c.determinant(matrix.AsIntrinsic())
+	return ele.NumberClass().Number(determinant)
+}
+
+func (c *matrixClass_) Reciprocal(
+	matrix MatrixLike,
+) MatrixLike {
+	c.validateSquare(matrix)
+	var values = matrix.AsIntrinsic()
+	var size = len(values)
+	if size > 3 {
+		return c.matrixFromComplex(c.eliminated(values))
+	}
+	var determinant = c.determinant(values)
+	if determinant == 0 {
+		var message = fmt.Sprintf(
+			"A singular matrix has no reciprocal: %v",
+			matrix,
+		)
+		panic(message)
+	}
+	var reciprocal = make([][]complex128, size)
+	for row := range reciprocal {
+		reciprocal[row] = make([]complex128, size)
+		for column := range reciprocal[row] {
+			// The reciprocal is the transposed matrix of cofactors (the
+			// adjugate) divided by the determinant.
+			var cofactor = c.determinant(c.minor(values, column, row))
+			if (row+column)%2 == 1 {
+				cofactor = -cofactor
+			}
+			reciprocal[row][column] = cofactor / determinant
+		}
+	}
+	return c.matrixFromComplex(reciprocal)
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v matrix_) GetClass() MatrixClassLike {
+	return matrixClass()
+}
+
+func (v matrix_) AsIntrinsic() [][]complex128 {
+	var elements = v.values_.AsIntrinsic()
+	var columns = int(v.columns_)
+	var values = make([][]complex128, len(elements)/columns)
+	for row := range values {
+		values[row] = elements[row*columns : (row+1)*columns]
+	}
+	return values
+}
+
+func (v matrix_) AsSource() string {
+	var sources = make([]string, 0, v.GetRows())
+	for _, row := range v.AsArray() {
+		sources = append(sources, row.AsSource())
+	}
+	return "[" + sts.Join(sources, ", ") + "]"
+}
+
+// Attribute Methods
+
+func (v matrix_) GetRows() uint {
+	return v.values_.GetSize() / v.columns_
+}
+
+func (v matrix_) GetColumns() uint {
+	return v.columns_
+}
+
+func (v matrix_) GetElement(
+	row int,
+	column int,
+) ele.NumberLike {
+	return v.GetRow(row).GetValue(column)
+}
+
+func (v matrix_) GetRow(
+	index int,
+) VectorLike {
+	var rows = v.AsArray()
+	var size = uti.ArraySize(rows)
+	var goIndex = uti.RelativeToCardinal(index, size)
+	return rows[goIndex]
+}
+
+func (v matrix_) GetColumn(
+	index int,
+) VectorLike {
+	var size = v.columns_
+	var goIndex = uti.RelativeToCardinal(index, size)
+	var values = v.AsIntrinsic()
+	var column = make([]complex128, len(values))
+	for row := range values {
+		column[row] = values[row][goIndex]
+	}
+	return vectorClass().vectorFromComplex(column)
+}
+
+// Sequential[VectorLike] Methods
+
+func (v matrix_) IsEmpty() bool {
+	return false
+}
+
+func (v matrix_) GetSize() uint {
+	return v.GetRows()
+}
+
+func (v matrix_) AsArray() []VectorLike {
+	var values = v.AsIntrinsic()
+	var rows = make([]VectorLike, len(values))
+	for index, row := range values {
+		rows[index] = vectorClass().vectorFromComplex(row)
+	}
+	return rows
+}
+
+func (v matrix_) GetIterator() uti.Ratcheted[VectorLike] {
+	return uti.Iterator(v.AsArray())
+}
+
+// PROTECTED INTERFACE
+
+func (v matrix_) String() string {
+	return v.AsSource()
+}
+
+func (v matrix_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *matrix_) UnmarshalText(
+	text []byte,
+) error {
+	var matrix, err = matrixClass().ParseMatrix(string(text))
+	if err != nil {
+		return err
+	}
+	*v = matrix.(matrix_)
+	return nil
+}
+
+func (v matrix_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *matrix_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(source))
+}
+
+func (v matrix_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	// Each row is encoded natively by the vector class.
+	var rows = v.AsArray()
+	var items = make([]jsn.RawMessage, len(rows))
+	for index, row := range rows {
+		items[index], err = ele.JsonClass().Marshal(row, ele.NativeEncoding)
+		if err != nil {
+			return
+		}
+	}
+	return jsn.Marshal(items)
+}
+
+func (v *matrix_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	var items []jsn.RawMessage
+	var err = jsn.Unmarshal(bytes, &items)
+	if err != nil {
+		return err
+	}
+	var values = make([][]complex128, len(items))
+	for index, item := range items {
+		var row vector_
+		err = row.UnmarshalNativeJSON(item)
+		if err != nil {
+			return err
+		}
+		values[index] = row.AsIntrinsic()
+		if len(values[index]) == 0 || len(values[index]) != len(values[0]) {
+			return fmt.Errorf("The JSON value is not a matrix: %s", bytes)
+		}
+	}
+	if len(values) == 0 {
+		return fmt.Errorf("The JSON value is not a matrix: %s", bytes)
+	}
+	*v = matrixClass().matrixFromComplex(values)
+	return nil
+}
+
+func (v matrix_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = ele.FormatClass().Header(MatrixType)
+	bytes = bin.AppendUvarint(bytes, uint64(v.columns_))
+	bytes = append(bytes, v.values_...)
+	return
+}
+
+func (v *matrix_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = ele.FormatClass().Payload(MatrixType, bytes)
+	if err != nil {
+		return err
+	}
+	var columns, length = bin.Uvarint(payload)
+	var elements = payload[max(length, 0):]
+	if length <= 0 || columns == 0 || len(elements) == 0 ||
+		len(elements)%16 != 0 || uint64(len(elements)/16)%columns != 0 {
+		return fmt.Errorf("The binary payload is not a matrix: %x", payload)
+	}
+	*v = matrix_{
+		columns_: uint(columns),
+		values_:  vectorClass().vectorFromComplex(vector_(elements).AsIntrinsic()),
+	}
+	return nil
+}
+
+func (v matrix_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	var values = v.AsIntrinsic()
+	var rows = make([]any, len(values))
+	for index, row := range values {
+		rows[index] = vectorClass().cborFromComplex(row)
+	}
+	return ele.CborClass().Encode(matrixClass().cborTag_, rows)
+}
+
+func (v *matrix_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = ele.CborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != matrixClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not a matrix: %x", bytes)
+	}
+	var items, _ = value.([]any)
+	var values = make([][]complex128, len(items))
+	for index, item := range items {
+		var row, ok = vectorClass().complexFromCbor(item)
+		if !ok || len(row) == 0 || index > 0 && len(row) != len(values[0]) {
+			return fmt.Errorf("The CBOR data item is not a matrix: %x", bytes)
+		}
+		values[index] = row
+	}
+	if len(values) == 0 {
+		return fmt.Errorf("The CBOR data item is not a matrix: %x", bytes)
+	}
+	*v = matrixClass().matrixFromComplex(values)
+	return nil
+}
+
+func (v matrix_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *matrix_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a matrix: %v",
+			source,
+		)
+	}
+}
+
+// Private Methods
+
+// This private method returns the determinant of the specified square matrix
+// values.  Small matrices use cofactor expansion so that integer elements yield
+// exact results, larger ones use Gaussian elimination with partial pivoting.
+func (c *matrixClass_) determinant(values [][]complex128) complex128 {
+	var size = len(values)
+	switch size {
+	case 1:
+		return values[0][0]
+	case 2:
+		return values[0][0]*values[1][1] - values[0][1]*values[1][0]
+	case 3:
+		var determinant complex128
+		for column := range 3 {
+			var cofactor = values[0][column] * c.determinant(c.minor(values, 0, column))
+			if column == 1 {
+				cofactor = -cofactor
+			}
+			determinant += cofactor
+		}
+		return determinant
+	}
+	var rows = c.copied(values)
+	var determinant complex128 = 1
+	for pivot := range size {
+		var best = pivot
+		for row := pivot + 1; row < size; row++ {
+			if cmp.Abs(rows[row][pivot]) > cmp.Abs(rows[best][pivot]) {
+				best = row
+			}
+		}
+		if rows[best][pivot] == 0 {
+			return 0
+		}
+		if best != pivot {
+			rows[best], rows[pivot] = rows[pivot], rows[best]
+			determinant = -determinant
+		}
+		determinant *= rows[pivot][pivot]
+		for row := pivot + 1; row < size; row++ {
+			var factor = rows[row][pivot] / rows[pivot][pivot]
+			for column := pivot; column < size; column++ {
+				rows[row][column] -= factor * rows[pivot][column]
+			}
+		}
+	}
+	return determinant
+}
+
+// This private method returns a deep copy of the specified matrix values.
+func (c *matrixClass_) copied(values [][]complex128) [][]complex128 {
+	var copied = make([][]complex128, len(values))
+	for row := range values {
+		copied[row] = append([]complex128(nil), values[row]...)
+	}
+	return copied
+}
+
+// This private method returns the reciprocal of the specified square matrix
+// values using Gauss-Jordan elimination with partial pivoting.
+func (c *matrixClass_) eliminated(values [][]complex128) [][]complex128 {
+	var size = len(values)
+	var rows = c.copied(values)
+	var reciprocal = c.Identity(uint(size)).AsIntrinsic()
+	for pivot := range size {
+		var best = pivot
+		for row := pivot + 1; row < size; row++ {
+			if cmp.Abs(rows[row][pivot]) > cmp.Abs(rows[best][pivot]) {
+				best = row
+			}
+		}
+		if rows[best][pivot] == 0 {
+			var message = fmt.Sprintf(
+				"A singular matrix has no reciprocal: %v",
+				c.matrixFromComplex(values),
+			)
+			panic(message)
+		}
+		rows[best], rows[pivot] = rows[pivot], rows[best]
+		reciprocal[best], reciprocal[pivot] = reciprocal[pivot], reciprocal[best]
+		var divisor = rows[pivot][pivot]
+		for column := range size {
+			rows[pivot][column] /= divisor
+			reciprocal[pivot][column] /= divisor
+		}
+		for row := range size {
+			if row == pivot || rows[row][pivot] == 0 {
+				continue
+			}
+			var factor = rows[row][pivot]
+			for column := range size {
+				rows[row][column] -= factor * rows[pivot][column]
+				reciprocal[row][column] -= factor * reciprocal[pivot][column]
+			}
+		}
+	}
+	return reciprocal
+}
+
+// This private method applies the specified vector function to each pair of
+// corresponding rows in the specified matrices.
+func (c *matrixClass_) elementwise(
+	first MatrixLike,
+	second MatrixLike,
+	function func(VectorLike, VectorLike) VectorLike,
+) MatrixLike {
+	if first.GetRows() != second.GetRows() ||
+		first.GetColumns() != second.GetColumns() {
+		var message = fmt.Sprintf(
+			"The matrices must have the same dimensions: %vx%v and %vx%v",
+			first.GetRows(),
+			first.GetColumns(),
+			second.GetRows(),
+			second.GetColumns(),
+		)
+		panic(message)
+	}
+	var firstRows = first.AsArray()
+	var secondRows = second.AsArray()
+	var rows = make([]VectorLike, len(firstRows))
+	for index := range rows {
+		rows[index] = function(firstRows[index], secondRows[index])
+	}
+	return c.matrixFromVectors(rows)
+}
+
+// This private method returns the matrix containing the specified rows of
+// complex values, each normalized the same way as a number.
+func (c *matrixClass_) matrixFromComplex(values [][]complex128) matrix_ {
+	if len(values) == 0 || len(values[0]) == 0 {
+		panic("A matrix must have at least one row and one column.")
+	}
+	var columns = len(values[0])
+	var elements = make([]complex128, 0, len(values)*columns)
+	for _, row := range values {
+		if len(row) != columns {
+			var message = fmt.Sprintf(
+				"Each row of the matrix must have %v elements: %v",
+				columns,
+				row,
+			)
+			panic(message)
+		}
+		elements = append(elements, row...)
+	}
+	return matrix_{
+		columns_: uint(columns),
+		values_:  vectorClass().vectorFromComplex(elements),
+	}
+}
+
+// This private method returns the matrix containing the specified rows.
+func (c *matrixClass_) matrixFromVectors(rows []VectorLike) matrix_ {
+	var values = make([][]complex128, len(rows))
+	for index, row := range rows {
+		values[index] = row.AsIntrinsic()
+	}
+	return c.matrixFromComplex(values)
+}
+
+// This private method returns the specified matrix values with the specified
+// row and column removed.
+func (c *matrixClass_) minor(
+	values [][]complex128,
+	row int,
+	column int,
+) [][]complex128 {
+	var minor = make([][]complex128, 0, len(values)-1)
+	for index, elements := range values {
+		if index == row {
+			continue
+		}
+		var reduced = make([]complex128, 0, len(elements)-1)
+		reduced = append(reduced, elements[:column]...)
+		reduced = append(reduced, elements[column+1:]...)
+		minor = append(minor, reduced)
+	}
+	return minor
+}
+
+// This private method panics if the specified matrix is not square.
+func (c *matrixClass_) validateSquare(
+	matrix MatrixLike,
+) {
+	if matrix.GetRows() != matrix.GetColumns() {
+		var message = fmt.Sprintf(
+			"The matrix must be square: %vx%v",
+			matrix.GetRows(),
+			matrix.GetColumns(),
+		)
+		panic(message)
+	}
+}
+
+// Instance Structure
+
+type matrix_ struct {
+	columns_ uint
+	values_  vector_ // The elements in row-major order.
+}
+
+// Class Structure
+
+type matrixClass_ struct {
+	// Declare the class constants.
+	matcher_     *reg.Regexp
+	productions_ map[int]string
+	cborTag_     uint64
+}
+
+// Class Reference
+
+func matrixClass() *matrixClass_ {
+	return matrixClassReference_
+}
+
+// The matrix matcher is composed from the vector matcher (minus its leading "^"
+// anchor) so its capture groups are numbered as follows:
+//   - 1: the first row
+//   - vectorGroups + 2: each additional row
+var matrixClassReference_ = &matrixClass_{
+	// Initialize the class constants.
+	matcher_: reg.MustCompile(
+		"^\\[(" + vectorClass().matcher_.String()[1:] + ")(?:, (" +
+			vectorClass().matcher_.String()[1:] + "))*\\]",
+	),
+	productions_: map[int]string{
+		1:                                      "row",
+		vectorClass().matcher_.NumSubexp() + 2: "row",
+	},
+	cborTag_: 0x6364671b,
+}
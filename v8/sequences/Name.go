@@ -13,7 +13,11 @@
 package sequences
 
 import (
+	drv "database/sql/driver"
+	jsn "encoding/json"
 	fmt "fmt"
+	ele "github.com/craterdog/go-essential-primitives/v8/elements"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	reg "regexp"
 	sli "slices"
@@ -47,15 +51,31 @@ func (c *nameClass_) NameFromSequence(
 func (c *nameClass_) NameFromSource(
 	source string,
 ) NameLike {
-	var matches = c.matcher_.FindStringSubmatch(source)
+	var name, err = c.ParseName(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return name
+}
+
+func (c *nameClass_) ParseName(
+	source string,
+) (
+	name NameLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
 	if uti.IsUndefined(matches) {
-		var message = fmt.Sprintf(
-			"An illegal string was passed to the name constructor method: %s",
+		err = ele.ParseErrorClass().ParseErrorFromMatcher(
+			"name",
+			c.matcher_,
+			c.productions_,
 			source,
 		)
-		panic(message)
+		return
 	}
-	return name_(source)
+	name = name_(source)
+	return
 }
 
 // Constant Methods
@@ -207,6 +227,137 @@ func (v name_) String() string {
 	return v.AsSource()
 }
 
+func (v name_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *name_) UnmarshalText(
+	text []byte,
+) error {
+	var name, err = nameClass().ParseName(string(text))
+	if err != nil {
+		return err
+	}
+	*v = name.(name_)
+	return nil
+}
+
+func (v name_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *name_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(source))
+}
+
+func (v name_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsIntrinsic())
+}
+
+func (v *name_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	var segments []string
+	var err = jsn.Unmarshal(bytes, &segments)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte("/" + sts.Join(segments, "/")))
+}
+
+func (v name_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = ele.FormatClass().Header(NameType)
+	bytes = append(bytes, v.AsSource()...)
+	return
+}
+
+func (v *name_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = ele.FormatClass().Payload(NameType, bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(payload)
+}
+
+func (v name_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	var segments []any
+	for _, segment := range v.AsIntrinsic() {
+		segments = append(segments, segment)
+	}
+	return ele.CborClass().Encode(nameClass().cborTag_, segments)
+}
+
+func (v *name_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = ele.CborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != nameClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not a name: %x", bytes)
+	}
+	var items, _ = value.([]any)
+	var segments = make([]string, len(items))
+	for index, item := range items {
+		var text, ok = item.(string)
+		if !ok {
+			return fmt.Errorf("The CBOR data item is not a name: %x", bytes)
+		}
+		segments[index] = text
+	}
+	return v.UnmarshalText([]byte("/" + sts.Join(segments, "/")))
+}
+
+func (v name_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *name_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a name: %v",
+			source,
+		)
+	}
+}
+
 // Private Methods
 
 // NOTE:
@@ -231,7 +382,9 @@ type name_ string // This type must support the "comparable" type contraint.
 
 type nameClass_ struct {
 	// Declare the class constants.
-	matcher_ *reg.Regexp
+	matcher_     *reg.Regexp
+	productions_ map[int]string
+	cborTag_     uint64
 }
 
 // Class Reference
@@ -245,4 +398,8 @@ var nameClassReference_ = &nameClass_{
 	matcher_: reg.MustCompile(
 		"^(?:/(?:" + letter_ + "|" + digit_ + ")((-|\\.)?(?:" + letter_ + "|" + digit_ + "))+)+",
 	),
+	productions_: map[int]string{
+		1: "name segment",
+	},
+	cborTag_: 0x63646714,
 }
@@ -13,7 +13,11 @@
 package sequences
 
 import (
+	drv "database/sql/driver"
+	jsn "encoding/json"
 	fmt "fmt"
+	ele "github.com/craterdog/go-essential-primitives/v8/elements"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	reg "regexp"
 	sli "slices"
@@ -55,15 +59,31 @@ func (c *narrativeClass_) NarrativeFromSequence(
 func (c *narrativeClass_) NarrativeFromSource(
 	source string,
 ) NarrativeLike {
-	var matches = c.matcher_.FindStringSubmatch(source)
+	var narrative, err = c.ParseNarrative(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return narrative
+}
+
+func (c *narrativeClass_) ParseNarrative(
+	source string,
+) (
+	narrative NarrativeLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
 	if uti.IsUndefined(matches) {
-		var message = fmt.Sprintf(
-			"An illegal string was passed to the narrative constructor method: %s",
+		err = ele.ParseErrorClass().ParseErrorFromMatcher(
+			"narrative",
+			c.matcher_,
+			c.productions_,
 			source,
 		)
-		panic(message)
+		return
 	}
-	return narrative_(source)
+	narrative = narrative_(source)
+	return
 }
 
 // Constant Methods
@@ -213,6 +233,139 @@ func (v narrative_) String() string {
 	return v.AsSource()
 }
 
+func (v narrative_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *narrative_) UnmarshalText(
+	text []byte,
+) error {
+	var narrative, err = narrativeClass().ParseNarrative(string(text))
+	if err != nil {
+		return err
+	}
+	*v = narrative.(narrative_)
+	return nil
+}
+
+func (v narrative_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *narrative_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(source))
+}
+
+func (v narrative_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsIntrinsic())
+}
+
+func (v *narrative_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	var lines []string
+	var err = jsn.Unmarshal(bytes, &lines)
+	if err != nil {
+		return err
+	}
+	*v = narrativeClass().Narrative(lines).(narrative_)
+	return nil
+}
+
+func (v narrative_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = ele.FormatClass().Header(NarrativeType)
+	bytes = append(bytes, v.AsSource()...)
+	return
+}
+
+func (v *narrative_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = ele.FormatClass().Payload(NarrativeType, bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(payload)
+}
+
+func (v narrative_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	var lines []any
+	for _, line := range v.AsIntrinsic() {
+		lines = append(lines, line)
+	}
+	return ele.CborClass().Encode(narrativeClass().cborTag_, lines)
+}
+
+func (v *narrative_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = ele.CborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != narrativeClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not a narrative: %x", bytes)
+	}
+	var items, _ = value.([]any)
+	var lines = make([]string, len(items))
+	for index, item := range items {
+		var text, ok = item.(string)
+		if !ok {
+			return fmt.Errorf("The CBOR data item is not a narrative: %x", bytes)
+		}
+		lines[index] = text
+	}
+	*v = narrativeClass().Narrative(lines).(narrative_)
+	return nil
+}
+
+func (v narrative_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *narrative_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a narrative: %v",
+			source,
+		)
+	}
+}
+
 // Private Methods
 
 // NOTE:
@@ -235,7 +388,9 @@ type narrative_ string // This type must support the "comparable" type contraint
 
 type narrativeClass_ struct {
 	// Declare the class constants.
-	matcher_ *reg.Regexp
+	matcher_     *reg.Regexp
+	productions_ map[int]string
+	cborTag_     uint64
 }
 
 // Class Reference
@@ -249,4 +404,8 @@ var narrativeClassReference_ = &narrativeClass_{
 	matcher_: reg.MustCompile(
 		"^\">((?:" + any_ + "|" + eol_ + ")*?)<\"",
 	),
+	productions_: map[int]string{
+		1: "narrative lines",
+	},
+	cborTag_: 0x63646715,
 }
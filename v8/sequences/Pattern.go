@@ -13,7 +13,11 @@
 package sequences
 
 import (
+	drv "database/sql/driver"
+	jsn "encoding/json"
 	fmt "fmt"
+	ele "github.com/craterdog/go-essential-primitives/v8/elements"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	reg "regexp"
 	sli "slices"
@@ -56,16 +60,31 @@ func (c *patternClass_) PatternFromSequence(
 func (c *patternClass_) PatternFromSource(
 	source string,
 ) PatternLike {
+	var pattern, err = c.ParsePattern(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return pattern
+}
 
-	var matches = c.matcher_.FindStringSubmatch(source)
+func (c *patternClass_) ParsePattern(
+	source string,
+) (
+	pattern PatternLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
 	if uti.IsUndefined(matches) {
-		var message = fmt.Sprintf(
-			"An illegal string was passed to the pattern constructor method: %s",
+		err = ele.ParseErrorClass().ParseErrorFromMatcher(
+			"pattern",
+			c.matcher_,
+			c.productions_,
 			source,
 		)
-		panic(message)
+		return
 	}
-	return pattern_(source)
+	pattern = pattern_(source)
+	return
 }
 
 // Constant Methods
@@ -237,6 +256,138 @@ func (v pattern_) String() string {
 	return v.AsSource()
 }
 
+func (v pattern_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *pattern_) UnmarshalText(
+	text []byte,
+) error {
+	var pattern, err = patternClass().ParsePattern(string(text))
+	if err != nil {
+		return err
+	}
+	*v = pattern.(pattern_)
+	return nil
+}
+
+func (v pattern_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *pattern_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(source))
+}
+
+func (v pattern_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(string(v.AsIntrinsic()))
+}
+
+func (v *pattern_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	var text string
+	var err = jsn.Unmarshal(bytes, &text)
+	if err != nil {
+		return err
+	}
+	_, err = reg.Compile(text)
+	if err != nil {
+		return err
+	}
+	*v = patternClass().Pattern([]rune(text)).(pattern_)
+	return nil
+}
+
+func (v pattern_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = ele.FormatClass().Header(PatternType)
+	bytes = append(bytes, v.AsSource()...)
+	return
+}
+
+func (v *pattern_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = ele.FormatClass().Payload(PatternType, bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(payload)
+}
+
+func (v pattern_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	return ele.CborClass().Encode(patternClass().cborTag_, string(v.AsIntrinsic()))
+}
+
+func (v *pattern_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = ele.CborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != patternClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not a pattern: %x", bytes)
+	}
+	var text, ok = value.(string)
+	if !ok {
+		return fmt.Errorf("The CBOR data item is not a pattern: %x", bytes)
+	}
+	_, err = reg.Compile(text)
+	if err != nil {
+		return err
+	}
+	*v = patternClass().Pattern([]rune(text)).(pattern_)
+	return nil
+}
+
+func (v pattern_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *pattern_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a pattern: %v",
+			source,
+		)
+	}
+}
+
 // Private Methods
 
 // NOTE:
@@ -247,7 +398,7 @@ func (v pattern_) String() string {
 // each name to lessen the chance of a name collision with other private Go
 // class constants in this package.
 const (
-	regex_ = "\"((?:" + character_ + ")+)\"\\\\?"
+	regex_ = "\"((?:" + character_ + ")+)\"\\?"
 )
 
 // Instance Structure
@@ -258,9 +409,11 @@ type pattern_ string // This type must support the "comparable" type contraint.
 
 type patternClass_ struct {
 	// Declare the class constants.
-	matcher_ *reg.Regexp
-	none_    PatternLike
-	any_     PatternLike
+	matcher_     *reg.Regexp
+	productions_ map[int]string
+	none_        PatternLike
+	any_         PatternLike
+	cborTag_     uint64
 }
 
 // Class Reference
@@ -272,6 +425,10 @@ func patternClass() *patternClass_ {
 var patternClassReference_ = &patternClass_{
 	// Initialize the class constants.
 	matcher_: reg.MustCompile("^" + regex_ + "|any|none"),
+	productions_: map[int]string{
+		1: "regular expression",
+	},
 	none_:    pattern_(`none`),
 	any_:     pattern_(`any`),
+	cborTag_: 35,
 }
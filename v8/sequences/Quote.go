@@ -13,7 +13,11 @@
 package sequences
 
 import (
+	drv "database/sql/driver"
+	jsn "encoding/json"
 	fmt "fmt"
+	ele "github.com/craterdog/go-essential-primitives/v8/elements"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	reg "regexp"
 	sli "slices"
@@ -45,15 +49,31 @@ func (c *quoteClass_) QuoteFromSequence(
 func (c *quoteClass_) QuoteFromSource(
 	source string,
 ) QuoteLike {
-	var matches = c.matcher_.FindStringSubmatch(source)
+	var quote, err = c.ParseQuote(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return quote
+}
+
+func (c *quoteClass_) ParseQuote(
+	source string,
+) (
+	quote QuoteLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
 	if uti.IsUndefined(matches) {
-		var message = fmt.Sprintf(
-			"An illegal string was passed to the quote constructor method: %s",
+		err = ele.ParseErrorClass().ParseErrorFromMatcher(
+			"quote",
+			c.matcher_,
+			c.productions_,
 			source,
 		)
-		panic(message)
+		return
 	}
-	return quote_(source)
+	quote = quote_(source)
+	return
 }
 
 // Constant Methods
@@ -195,6 +215,130 @@ func (v quote_) String() string {
 	return v.AsSource()
 }
 
+func (v quote_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *quote_) UnmarshalText(
+	text []byte,
+) error {
+	var quote, err = quoteClass().ParseQuote(string(text))
+	if err != nil {
+		return err
+	}
+	*v = quote.(quote_)
+	return nil
+}
+
+func (v quote_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *quote_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(source))
+}
+
+func (v quote_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(string(v.AsIntrinsic()))
+}
+
+func (v *quote_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	var text string
+	var err = jsn.Unmarshal(bytes, &text)
+	if err != nil {
+		return err
+	}
+	*v = quoteClass().Quote([]rune(text)).(quote_)
+	return nil
+}
+
+func (v quote_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = ele.FormatClass().Header(QuoteType)
+	bytes = append(bytes, v.AsSource()...)
+	return
+}
+
+func (v *quote_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = ele.FormatClass().Payload(QuoteType, bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(payload)
+}
+
+func (v quote_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	return ele.CborClass().Encode(0, string(v.AsIntrinsic()))
+}
+
+func (v *quote_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = ele.CborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != 0 {
+		return fmt.Errorf("The CBOR data item is not a quote: %x", bytes)
+	}
+	var text, ok = value.(string)
+	if !ok {
+		return fmt.Errorf("The CBOR data item is not a quote: %x", bytes)
+	}
+	*v = quoteClass().Quote([]rune(text)).(quote_)
+	return nil
+}
+
+func (v quote_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *quote_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a quote: %v",
+			source,
+		)
+	}
+}
+
 // Private Methods
 
 // NOTE:
@@ -220,7 +364,8 @@ type quote_ string // This type must support the "comparable" type contraint.
 
 type quoteClass_ struct {
 	// Declare the class constants.
-	matcher_ *reg.Regexp
+	matcher_     *reg.Regexp
+	productions_ map[int]string
 }
 
 // Class Reference
@@ -232,4 +377,7 @@ func quoteClass() *quoteClass_ {
 var quoteClassReference_ = &quoteClass_{
 	// Initialize the class constants.
 	matcher_: reg.MustCompile("^\"((?:" + character_ + ")*)\""),
+	productions_: map[int]string{
+		1: "quoted characters",
+	},
 }
@@ -0,0 +1,545 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package sequences
+
+import (
+	byt "bytes"
+	fmt "fmt"
+	ele "github.com/craterdog/go-essential-primitives/v8/elements"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	io "io"
+	reg "regexp"
+	uni "unicode"
+	utf "unicode/utf8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ScannerClass() ScannerClassLike {
+	return scannerClass()
+}
+
+// Constructor Methods
+
+func (c *scannerClass_) Scanner(
+	reader io.Reader,
+) ScannerLike {
+	if uti.IsUndefined(reader) {
+		panic("The \"reader\" attribute is required by this class.")
+	}
+	var instance = &scanner_{
+		// Initialize the instance attributes.
+		reader_: reader,
+	}
+	return instance
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *scanner_) GetClass() ScannerClassLike {
+	return scannerClass()
+}
+
+func (v *scanner_) ScanToken() bool {
+	// Clear out the previous token.
+	v.kind_ = ""
+	v.primitive_ = nil
+	v.start_ = v.offset_
+	v.end_ = v.offset_
+	if v.error_ != nil {
+		return false
+	}
+
+	// Skip past any whitespace and comments preceding the next token.
+	if !v.skipSeparators() {
+		return false
+	}
+	v.start_ = v.offset_
+	v.end_ = v.offset_
+	if len(v.buffer_) == 0 {
+		// There are no more tokens.
+		return false
+	}
+
+	// Find the longest primitive at the front of the buffer, reading in more of
+	// the source until at least a full chunk of lookahead follows the primitive.
+	// The lookahead is limited so that a malformed token cannot pull the rest of
+	// a large source into memory before it fails.
+	var class = scannerClass()
+	var limit = class.chunkSize_ * class.chunkLimit_
+	var candidate *candidate_
+	var length int
+	var checked int
+	for {
+		candidate, length = v.matchCandidate()
+		if v.exhausted_ || (candidate != nil && len(v.buffer_) >= length+class.chunkSize_) {
+			break
+		}
+		if candidate == nil && len(v.buffer_) >= 2*checked {
+			// No more of the source is needed once every candidate has failed
+			// before the end of the buffer.  The buffer length doubles between
+			// checks so that a long token is not parsed over and over.
+			checked = len(v.buffer_)
+			var furthest = v.furthestError()
+			if furthest.GetOffset() < uint(checked) {
+				v.error_ = furthest
+				return false
+			}
+		}
+		if len(v.buffer_) >= limit {
+			break
+		}
+		var size = class.chunkSize_
+		if candidate == nil {
+			// Double the buffer so that an unfinished token is not rescanned
+			// once per chunk.
+			size = max(size, len(v.buffer_))
+		}
+		if !v.fillBuffer(size) {
+			return false
+		}
+	}
+	if candidate == nil {
+		v.error_ = v.furthestError()
+		return false
+	}
+
+	// Parse the primitive and consume its source.
+	var source = string(v.buffer_[:length])
+	var primitive, err = candidate.parse(source)
+	if err != nil {
+		v.error_ = err
+		return false
+	}
+	v.kind_ = candidate.kind
+	v.primitive_ = primitive
+	v.end_ = v.start_ + uint(length)
+	v.consumeBytes(length)
+	return true
+}
+
+// Attribute Methods
+
+func (v *scanner_) GetKind() string {
+	return v.kind_
+}
+
+func (v *scanner_) GetPrimitive() any {
+	return v.primitive_
+}
+
+func (v *scanner_) GetStart() uint {
+	return v.start_
+}
+
+func (v *scanner_) GetEnd() uint {
+	return v.end_
+}
+
+func (v *scanner_) GetError() error {
+	return v.error_
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// This private method consumes the specified number of bytes from the front of
+// the buffer.
+func (v *scanner_) consumeBytes(
+	count int,
+) {
+	v.buffer_ = v.buffer_[count:]
+	v.offset_ += uint(count)
+}
+
+// This private method reads the specified number of bytes of the source into
+// the buffer.  It returns false if the reader failed for any reason other than
+// reaching the end of the source.
+func (v *scanner_) fillBuffer(
+	size int,
+) bool {
+	var chunk = make([]byte, size)
+	var count, err = io.ReadFull(v.reader_, chunk)
+	v.buffer_ = append(v.buffer_, chunk[:count]...)
+	switch {
+	case err == io.EOF || err == io.ErrUnexpectedEOF:
+		v.exhausted_ = true
+	case err != nil:
+		v.error_ = err
+		return false
+	}
+	return true
+}
+
+// This private method makes sure that the buffer contains either the specified
+// delimiter or the rest of the source, and returns the index of the delimiter
+// in the buffer (or -1 if there is no delimiter).
+func (v *scanner_) findDelimiter(
+	delimiter string,
+	from int,
+) (
+	index int,
+	ok bool,
+) {
+	for {
+		index = byt.Index(v.buffer_[from:], []byte(delimiter))
+		if index > -1 {
+			index += from
+			ok = true
+			return
+		}
+		if v.exhausted_ {
+			ok = true
+			return
+		}
+		if !v.fillBuffer(scannerClass().chunkSize_) {
+			return
+		}
+	}
+}
+
+// This private method skips past any whitespace, comments ("!>" through "<!")
+// and notes ("!" through the end of the line) at the front of the buffer.  It
+// returns false if the source could not be read or a comment is unterminated.
+func (v *scanner_) skipSeparators() bool {
+	for {
+		if len(v.buffer_) < utf.UTFMax && !v.exhausted_ {
+			if !v.fillBuffer(scannerClass().chunkSize_) {
+				return false
+			}
+			continue
+		}
+		if len(v.buffer_) == 0 {
+			return true
+		}
+		var rune_, size = utf.DecodeRune(v.buffer_)
+		switch {
+		case uni.IsSpace(rune_):
+			v.consumeBytes(size)
+		case byt.HasPrefix(v.buffer_, []byte("!>")):
+			var index, ok = v.findDelimiter("<!", 2)
+			if !ok {
+				return false
+			}
+			if index < 0 {
+				v.error_ = ele.ParseErrorClass().ParseError(
+					"comment",
+					string(v.buffer_),
+					uint(len(v.buffer_)),
+					"\"<!\"",
+				)
+				return false
+			}
+			v.consumeBytes(index + 2)
+		case rune_ == '!':
+			var index, ok = v.findDelimiter("\n", 1)
+			if !ok {
+				return false
+			}
+			if index < 0 {
+				index = len(v.buffer_)
+			}
+			v.consumeBytes(index)
+		default:
+			return true
+		}
+	}
+}
+
+// This private method returns the candidate that matches the most bytes at the
+// front of the buffer along with the number of bytes it matched.  When several
+// candidates match the same number of bytes the first one takes precedence.
+func (v *scanner_) matchCandidate() (
+	candidate *candidate_,
+	length int,
+) {
+	var candidates = scannerClass().candidates_
+	for index := range candidates {
+		var location = candidates[index].matcher.FindIndex(v.buffer_)
+		if location != nil && location[1] > length {
+			candidate = &candidates[index]
+			length = location[1]
+		}
+	}
+	return
+}
+
+// This private method returns the parse error from whichever candidate got
+// furthest into the front of the buffer before failing.  The offset in the
+// parse error is relative to the start of the failed token.
+func (v *scanner_) furthestError() ele.ParseErrorLike {
+	var source = string(v.buffer_)
+	var furthest ele.ParseErrorLike
+	for _, candidate := range scannerClass().candidates_ {
+		var _, err = candidate.parse(source)
+		var parseError, ok = err.(ele.ParseErrorLike)
+		if ok && (furthest == nil || parseError.GetOffset() > furthest.GetOffset()) {
+			furthest = parseError
+		}
+	}
+	if furthest == nil {
+		furthest = ele.ParseErrorClass().ParseError(
+			"primitive",
+			source,
+			0,
+			"a primitive",
+		)
+	}
+	return furthest
+}
+
+// This private function anchors every alternative in the specified matcher to
+// the front of the source since not all of the class matchers do so.
+func anchorMatcher(
+	matcher *reg.Regexp,
+) *reg.Regexp {
+	return reg.MustCompile("^(?:" + matcher.String() + ")")
+}
+
+// This private function returns the specified candidates in order of the shared
+// primitive precedence, recording the kind of primitive in each candidate.
+func candidatesInPrecedence(
+	candidates map[string]candidate_,
+) []candidate_ {
+	var ordered = make([]candidate_, 0, len(candidates))
+	for _, kind := range gra.PrimitivePrecedence() {
+		var candidate, ok = candidates[kind]
+		if !ok {
+			var message = fmt.Sprintf(
+				"The scanner has no candidate for the kind of primitive: %v",
+				kind,
+			)
+			panic(message)
+		}
+		candidate.kind = kind
+		ordered = append(ordered, candidate)
+	}
+	return ordered
+}
+
+// This private structure associates a kind of primitive with its matcher and
+// parse function.
+type candidate_ struct {
+	kind    string
+	matcher *reg.Regexp
+	parse   func(source string) (any, error)
+}
+
+// Instance Structure
+
+type scanner_ struct {
+	// Declare the instance attributes.
+	reader_    io.Reader
+	buffer_    []byte
+	offset_    uint
+	exhausted_ bool
+	kind_      string
+	primitive_ any
+	start_     uint
+	end_       uint
+	error_     error
+}
+
+// Class Structure
+
+type scannerClass_ struct {
+	// Declare the class constants.
+	chunkSize_  int
+	chunkLimit_ int
+	candidates_ []candidate_
+}
+
+// Class Reference
+
+func scannerClass() *scannerClass_ {
+	return scannerClassReference_
+}
+
+// NOTE:
+// The candidates are ordered by the shared precedence of the primitives, which
+// only matters when two candidates match the same number of characters (e.g.
+// "true" is both a boolean and an identifier).  The ParsePrimitive() function
+// uses the same scanner so the same source always yields the same primitive.
+var scannerClassReference_ = &scannerClass_{
+	// Initialize the class constants.
+	chunkSize_:  4096,
+	chunkLimit_: 256,
+	candidates_: candidatesInPrecedence(map[string]candidate_{
+		"duration": {
+			matcher: anchorMatcher(gra.Matcher("duration")),
+			parse: func(source string) (any, error) {
+				return ele.DurationClass().ParseDuration(source)
+			},
+		},
+		"angle": {
+			matcher: anchorMatcher(gra.Matcher("angle")),
+			parse: func(source string) (any, error) {
+				return ele.AngleClass().ParseAngle(source)
+			},
+		},
+		"moment": {
+			matcher: anchorMatcher(gra.Matcher("moment")),
+			parse: func(source string) (any, error) {
+				return ele.MomentClass().ParseMoment(source)
+			},
+		},
+		"interval": {
+			matcher: anchorMatcher(gra.Matcher("interval")),
+			parse: func(source string) (any, error) {
+				return ele.IntervalClass().ParseInterval(source)
+			},
+		},
+		"resource": {
+			matcher: anchorMatcher(gra.Matcher("resource")),
+			parse: func(source string) (any, error) {
+				return ele.ResourceClass().ParseResource(source)
+			},
+		},
+		"tag": {
+			matcher: anchorMatcher(tagClass().matcher_),
+			parse: func(source string) (any, error) {
+				return tagClass().ParseTag(source)
+			},
+		},
+		"symbol": {
+			matcher: anchorMatcher(symbolClass().matcher_),
+			parse: func(source string) (any, error) {
+				return symbolClass().ParseSymbol(source)
+			},
+		},
+		"name": {
+			matcher: anchorMatcher(nameClass().matcher_),
+			parse: func(source string) (any, error) {
+				return nameClass().ParseName(source)
+			},
+		},
+		"binary": {
+			matcher: anchorMatcher(binaryClass().matcher_),
+			parse: func(source string) (any, error) {
+				return binaryClass().ParseBinary(source)
+			},
+		},
+		"bytecode": {
+			matcher: anchorMatcher(bytecodeClass().matcher_),
+			parse: func(source string) (any, error) {
+				return bytecodeClass().ParseBytecode(source)
+			},
+		},
+		"glyph": {
+			matcher: anchorMatcher(gra.Matcher("glyph")),
+			parse: func(source string) (any, error) {
+				return ele.GlyphClass().ParseGlyph(source)
+			},
+		},
+		"narrative": {
+			matcher: anchorMatcher(narrativeClass().matcher_),
+			parse: func(source string) (any, error) {
+				return narrativeClass().ParseNarrative(source)
+			},
+		},
+		"quote": {
+			matcher: anchorMatcher(quoteClass().matcher_),
+			parse: func(source string) (any, error) {
+				return quoteClass().ParseQuote(source)
+			},
+		},
+		"pattern": {
+			matcher: anchorMatcher(patternClass().matcher_),
+			parse: func(source string) (any, error) {
+				return patternClass().ParsePattern(source)
+			},
+		},
+		"recurrence": {
+			matcher: anchorMatcher(gra.Matcher("recurrence")),
+			parse: func(source string) (any, error) {
+				return ele.RecurrenceClass().ParseRecurrence(source)
+			},
+		},
+		"boolean": {
+			matcher: anchorMatcher(gra.Matcher("boolean")),
+			parse: func(source string) (any, error) {
+				return ele.BooleanClass().ParseBoolean(source)
+			},
+		},
+		"version": {
+			matcher: anchorMatcher(versionClass().matcher_),
+			parse: func(source string) (any, error) {
+				return versionClass().ParseVersion(source)
+			},
+		},
+		"probability": {
+			matcher: anchorMatcher(gra.Matcher("probability")),
+			parse: func(source string) (any, error) {
+				return ele.ProbabilityClass().ParseProbability(source)
+			},
+		},
+		"percentage": {
+			matcher: anchorMatcher(gra.Matcher("percentage")),
+			parse: func(source string) (any, error) {
+				return ele.PercentageClass().ParsePercentage(source)
+			},
+		},
+		"decimal": {
+			matcher: anchorMatcher(gra.Matcher("decimal")),
+			parse: func(source string) (any, error) {
+				return ele.DecimalClass().ParseDecimal(source)
+			},
+		},
+		"rational": {
+			matcher: anchorMatcher(gra.Matcher("rational")),
+			parse: func(source string) (any, error) {
+				return ele.RationalClass().ParseRational(source)
+			},
+		},
+		"integer": {
+			matcher: anchorMatcher(gra.Matcher("integer")),
+			parse: func(source string) (any, error) {
+				return ele.IntegerClass().ParseInteger(source)
+			},
+		},
+		"number": {
+			matcher: anchorMatcher(gra.Matcher("number")),
+			parse: func(source string) (any, error) {
+				return ele.NumberClass().ParseNumber(source)
+			},
+		},
+		"identifier": {
+			matcher: anchorMatcher(identifierClass().matcher_),
+			parse: func(source string) (any, error) {
+				return identifierClass().ParseIdentifier(source)
+			},
+		},
+		"matrix": {
+			matcher: anchorMatcher(matrixClass().matcher_),
+			parse: func(source string) (any, error) {
+				return matrixClass().ParseMatrix(source)
+			},
+		},
+		"vector": {
+			matcher: anchorMatcher(vectorClass().matcher_),
+			parse: func(source string) (any, error) {
+				return vectorClass().ParseVector(source)
+			},
+		},
+	}),
+}
@@ -13,7 +13,11 @@
 package sequences
 
 import (
+	drv "database/sql/driver"
+	jsn "encoding/json"
 	fmt "fmt"
+	ele "github.com/craterdog/go-essential-primitives/v8/elements"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	reg "regexp"
 	sli "slices"
@@ -44,15 +48,31 @@ func (c *symbolClass_) SymbolFromSequence(
 func (c *symbolClass_) SymbolFromSource(
 	source string,
 ) SymbolLike {
-	var matches = c.matcher_.FindStringSubmatch(source)
+	var symbol, err = c.ParseSymbol(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return symbol
+}
+
+func (c *symbolClass_) ParseSymbol(
+	source string,
+) (
+	symbol SymbolLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
 	if uti.IsUndefined(matches) {
-		var message = fmt.Sprintf(
-			"An illegal string was passed to the symbol constructor method: %s",
+		err = ele.ParseErrorClass().ParseErrorFromMatcher(
+			"symbol",
+			c.matcher_,
+			c.productions_,
 			source,
 		)
-		panic(message)
+		return
 	}
-	return symbol_(matches[1]) // Strip off the leading "$".
+	symbol = symbol_(matches[1]) // Strip off the leading "$".
+	return
 }
 
 // Constant Methods
@@ -190,6 +210,125 @@ func (v symbol_) String() string {
 	return v.AsSource()
 }
 
+func (v symbol_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *symbol_) UnmarshalText(
+	text []byte,
+) error {
+	var symbol, err = symbolClass().ParseSymbol(string(text))
+	if err != nil {
+		return err
+	}
+	*v = symbol.(symbol_)
+	return nil
+}
+
+func (v symbol_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *symbol_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(source))
+}
+
+func (v symbol_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(string(v.AsIntrinsic()))
+}
+
+func (v *symbol_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	var text string
+	var err = jsn.Unmarshal(bytes, &text)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte("$" + text))
+}
+
+func (v symbol_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = ele.FormatClass().Header(SymbolType)
+	bytes = append(bytes, v.AsSource()...)
+	return
+}
+
+func (v *symbol_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = ele.FormatClass().Payload(SymbolType, bytes)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText(payload)
+}
+
+func (v symbol_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	return ele.CborClass().Encode(symbolClass().cborTag_, string(v.AsIntrinsic()))
+}
+
+func (v *symbol_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = ele.CborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != symbolClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not a symbol: %x", bytes)
+	}
+	var text, _ = value.(string)
+	return v.UnmarshalText([]byte("$" + text))
+}
+
+func (v symbol_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *symbol_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a symbol: %v",
+			source,
+		)
+	}
+}
+
 // Private Methods
 
 // Instance Structure
@@ -200,8 +339,10 @@ type symbol_ string
 
 type symbolClass_ struct {
 	// Declare the class constants.
-	matcher_   *reg.Regexp
-	undefined_ SymbolLike
+	matcher_     *reg.Regexp
+	productions_ map[int]string
+	undefined_   SymbolLike
+	cborTag_     uint64
 }
 
 // Class Reference
@@ -215,5 +356,9 @@ var symbolClassReference_ = &symbolClass_{
 	matcher_: reg.MustCompile(
 		"^\\$((?:" + letter_ + ")((-)?(?:" + letter_ + "|" + digit_ + "))*)",
 	),
+	productions_: map[int]string{
+		1: "identifier",
+	},
 	undefined_: symbol_("$"),
+	cborTag_:   0x63646718,
 }
@@ -13,8 +13,12 @@
 package sequences
 
 import (
+	drv "database/sql/driver"
 	bin "encoding/binary"
+	jsn "encoding/json"
 	fmt "fmt"
+	ele "github.com/craterdog/go-essential-primitives/v8/elements"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	reg "regexp"
 	sli "slices"
@@ -57,15 +61,31 @@ func (c *tagClass_) TagFromSequence(
 func (c *tagClass_) TagFromSource(
 	source string,
 ) TagLike {
-	var matches = c.matcher_.FindStringSubmatch(source)
+	var tag, err = c.ParseTag(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return tag
+}
+
+func (c *tagClass_) ParseTag(
+	source string,
+) (
+	tag TagLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
 	if uti.IsUndefined(matches) {
-		var message = fmt.Sprintf(
-			"An illegal string was passed to the tag constructor method: %s",
+		err = ele.ParseErrorClass().ParseErrorFromMatcher(
+			"tag",
+			c.matcher_,
+			c.productions_,
 			source,
 		)
-		panic(message)
+		return
 	}
-	return tag_(source)
+	tag = tag_(source)
+	return
 }
 
 // Constant Methods
@@ -212,6 +232,146 @@ func (v tag_) String() string {
 	return v.AsSource()
 }
 
+func (v tag_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *tag_) UnmarshalText(
+	text []byte,
+) error {
+	var tag, err = tagClass().ParseTag(string(text))
+	if err != nil {
+		return err
+	}
+	*v = tag.(tag_)
+	return nil
+}
+
+func (v tag_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *tag_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(source))
+}
+
+func (v tag_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource()[1:])
+}
+
+func (v *tag_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	var text string
+	var err = jsn.Unmarshal(bytes, &text)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte("#" + text))
+}
+
+func (v tag_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = ele.FormatClass().Header(TagType)
+	bytes = append(bytes, v.AsIntrinsic()...)
+	return
+}
+
+func (v *tag_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = ele.FormatClass().Payload(TagType, bytes)
+	if err != nil {
+		return err
+	}
+	if len(payload) < 8 {
+		return fmt.Errorf(
+			"A tag must be at least eight bytes long: %v",
+			len(payload),
+		)
+	}
+	*v = tagClass().Tag(payload).(tag_)
+	return nil
+}
+
+func (v tag_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	return ele.CborClass().Encode(tagClass().cborTag_, v.AsIntrinsic())
+}
+
+func (v *tag_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = ele.CborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != tagClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not a tag: %x", bytes)
+	}
+	var intrinsic, _ = value.([]byte)
+	if len(intrinsic) < 8 {
+		return fmt.Errorf(
+			"A tag must be at least eight bytes long: %v",
+			len(intrinsic),
+		)
+	}
+	*v = tagClass().Tag(intrinsic).(tag_)
+	return nil
+}
+
+func (v tag_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsIntrinsic()
+	return
+}
+
+func (v *tag_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case []byte:
+		if len(actual) < 8 {
+			return fmt.Errorf(
+				"A tag must be at least eight bytes long: %v",
+				len(actual),
+			)
+		}
+		*v = tagClass().Tag(actual).(tag_)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a tag: %v",
+			source,
+		)
+	}
+}
+
 // Private Methods
 
 func (c *tagClass_) validateSize(
@@ -245,7 +405,9 @@ type tag_ string // This type must support the "comparable" type contraint.
 
 type tagClass_ struct {
 	// Declare the class constants.
-	matcher_ *reg.Regexp
+	matcher_     *reg.Regexp
+	productions_ map[int]string
+	cborTag_     uint64
 }
 
 // Class Reference
@@ -257,4 +419,8 @@ func tagClass() *tagClass_ {
 var tagClassReference_ = &tagClass_{
 	// Initialize the class constants.
 	matcher_: reg.MustCompile("^#((?:" + base32_ + ")+)"),
+	productions_: map[int]string{
+		1: "base 32 characters",
+	},
+	cborTag_: 0x63646719,
 }
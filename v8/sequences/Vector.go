@@ -0,0 +1,621 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package sequences
+
+import (
+	drv "database/sql/driver"
+	bin "encoding/binary"
+	jsn "encoding/json"
+	fmt "fmt"
+	ele "github.com/craterdog/go-essential-primitives/v8/elements"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	mat "math"
+	reg "regexp"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func VectorClass() VectorClassLike {
+	return vectorClass()
+}
+
+// Constructor Methods
+
+func (c *vectorClass_) Vector(
+	values []complex128,
+) VectorLike {
+	return c.vectorFromComplex(values)
+}
+
+func (c *vectorClass_) VectorFromSequence(
+	sequence Sequential[ele.NumberLike],
+) VectorLike {
+	return c.vectorFromNumbers(sequence.AsArray())
+}
+
+func (c *vectorClass_) VectorFromSource(
+	source string,
+) VectorLike {
+	var vector, err = c.ParseVector(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return vector
+}
+
+func (c *vectorClass_) ParseVector(
+	source string,
+) (
+	vector VectorLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
+	if uti.IsUndefined(matches) {
+		err = ele.ParseErrorClass().ParseErrorFromMatcher(
+			"vector",
+			c.matcher_,
+			c.productions_,
+			source,
+		)
+		return
+	}
+	vector = c.vectorFromComplex(c.complexFromSource(matches[0]))
+	return
+}
+
+// Constant Methods
+
+// Function Methods
+
+func (c *vectorClass_) Inverse(
+	vector VectorLike,
+) VectorLike {
+	var numbers = vector.AsArray()
+	for index, number := range numbers {
+		numbers[index] = ele.NumberClass().Inverse(number)
+	}
+	return c.vectorFromNumbers(numbers)
+}
+
+func (c *vectorClass_) Sum(
+	first VectorLike,
+	second VectorLike,
+) VectorLike {
+	return c.elementwise(first, second, ele.NumberClass().Sum)
+}
+
+func (c *vectorClass_) Difference(
+	first VectorLike,
+	second VectorLike,
+) VectorLike {
+	return c.elementwise(first, second, ele.NumberClass().Difference)
+}
+
+func (c *vectorClass_) Scaled(
+	vector VectorLike,
+	factor ele.NumberLike,
+) VectorLike {
+	var numbers = vector.AsArray()
+	for index, number := range numbers {
+		numbers[index] = ele.NumberClass().Product(number, factor)
+	}
+	return c.vectorFromNumbers(numbers)
+}
+
+func (c *vectorClass_) Product(
+	first VectorLike,
+	second VectorLike,
+) VectorLike {
+	return c.elementwise(first, second, ele.NumberClass().Product)
+}
+
+func (c *vectorClass_) Quotient(
+	first VectorLike,
+	second VectorLike,
+) VectorLike {
+	return c.elementwise(first, second, ele.NumberClass().Quotient)
+}
+
+func (c *vectorClass_) DotProduct(
+	first VectorLike,
+	second VectorLike,
+) ele.NumberLike {
+	var products = c.Product(first, second).AsArray()
+	var sum = ele.NumberClass().Zero()
+	for _, product := range products {
+		sum = ele.NumberClass().Sum(sum, product)
+	}
+	return sum
+}
+
+func (c *vectorClass_) CrossProduct(
+	first VectorLike,
+	second VectorLike,
+) VectorLike {
+	if first.GetSize() != 3 || second.GetSize() != 3 {
+		var message = fmt.Sprintf(
+			"A cross product requires two vectors of size 3: %v and %v",
+			first.GetSize(),
+			second.GetSize(),
+		)
+		panic(message)
+	}
+	var a = first.AsIntrinsic()
+	var b = second.AsIntrinsic()
+	return c.vectorFromComplex([]complex128{
+		a[1]*b[2] - a[2]*b[1],
+		a[2]*b[0] - a[0]*b[2],
+		a[0]*b[1] - a[1]*b[0],
+	})
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v vector_) GetClass() VectorClassLike {
+	return vectorClass()
+}
+
+func (v vector_) AsIntrinsic() []complex128 {
+	var bytes = []byte(v)
+	var values = make([]complex128, len(bytes)/16)
+	for index := range values {
+		var realPart = bin.BigEndian.Uint64(bytes[16*index:])
+		var imaginaryPart = bin.BigEndian.Uint64(bytes[16*index+8:])
+		values[index] = complex(
+			mat.Float64frombits(realPart),
+			mat.Float64frombits(imaginaryPart),
+		)
+	}
+	return values
+}
+
+func (v vector_) AsSource() string {
+	var sources = make([]string, 0, v.GetSize())
+	for _, number := range v.AsArray() {
+		sources = append(sources, number.AsSource())
+	}
+	return "[" + sts.Join(sources, ", ") + "]"
+}
+
+// Attribute Methods
+
+// Accessible[ele.NumberLike] Methods
+
+func (v vector_) GetValue(
+	index int,
+) ele.NumberLike {
+	var numbers = v.AsArray()
+	var size = uti.ArraySize(numbers)
+	var goIndex = uti.RelativeToCardinal(index, size)
+	return numbers[goIndex]
+}
+
+func (v vector_) GetValues(
+	first int,
+	last int,
+) Sequential[ele.NumberLike] {
+	var numbers = v.AsArray()
+	var size = uti.ArraySize(numbers)
+	var goFirst = uti.RelativeToCardinal(first, size)
+	var goLast = uti.RelativeToCardinal(last, size)
+	return vectorClass().vectorFromNumbers(numbers[goFirst : goLast+1])
+}
+
+func (v vector_) GetIndex(
+	value ele.NumberLike,
+) int {
+	var index int
+	var iterator = v.GetIterator()
+	for iterator.HasNext() {
+		index++
+		var candidate = iterator.GetNext()
+		if candidate == value {
+			// Found the value.
+			return index
+		}
+	}
+	// The value was not found.
+	return 0
+}
+
+// Sequential[ele.NumberLike] Methods
+
+func (v vector_) IsEmpty() bool {
+	return len(v) == 0
+}
+
+func (v vector_) GetSize() uint {
+	return uint(len(v) / 16)
+}
+
+func (v vector_) AsArray() []ele.NumberLike {
+	var values = v.AsIntrinsic()
+	var numbers = make([]ele.NumberLike, len(values))
+	for index, value := range values {
+		numbers[index] = ele.NumberClass().Number(value)
+	}
+	return numbers
+}
+
+func (v vector_) GetIterator() uti.Ratcheted[ele.NumberLike] {
+	return uti.Iterator(v.AsArray())
+}
+
+// PROTECTED INTERFACE
+
+func (v vector_) String() string {
+	return v.AsSource()
+}
+
+func (v vector_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *vector_) UnmarshalText(
+	text []byte,
+) error {
+	var vector, err = vectorClass().ParseVector(string(text))
+	if err != nil {
+		return err
+	}
+	*v = vector.(vector_)
+	return nil
+}
+
+func (v vector_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *vector_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(source))
+}
+
+func (v vector_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	// Each element is encoded natively by the number class.
+	var numbers = v.AsArray()
+	var items = make([]jsn.RawMessage, len(numbers))
+	for index, number := range numbers {
+		items[index], err = ele.JsonClass().Marshal(number, ele.NativeEncoding)
+		if err != nil {
+			return
+		}
+	}
+	return jsn.Marshal(items)
+}
+
+func (v *vector_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	var items []jsn.RawMessage
+	var err = jsn.Unmarshal(bytes, &items)
+	if err != nil {
+		return err
+	}
+	var values = make([]complex128, len(items))
+	for index, item := range items {
+		values[index], err = vectorClass().complexFromJson(item)
+		if err != nil {
+			return err
+		}
+	}
+	*v = vectorClass().vectorFromComplex(values)
+	return nil
+}
+
+func (v vector_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = ele.FormatClass().Header(VectorType)
+	bytes = append(bytes, v...)
+	return
+}
+
+func (v *vector_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = ele.FormatClass().Payload(VectorType, bytes)
+	if err != nil {
+		return err
+	}
+	if len(payload)%16 != 0 {
+		return fmt.Errorf("The binary payload is not a vector: %x", payload)
+	}
+	*v = vectorClass().vectorFromComplex(vector_(payload).AsIntrinsic())
+	return nil
+}
+
+func (v vector_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	var class = vectorClass()
+	return ele.CborClass().Encode(
+		class.cborTag_,
+		class.cborFromComplex(v.AsIntrinsic()),
+	)
+}
+
+func (v *vector_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = ele.CborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != vectorClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not a vector: %x", bytes)
+	}
+	var values, ok = vectorClass().complexFromCbor(value)
+	if !ok {
+		return fmt.Errorf("The CBOR data item is not a vector: %x", bytes)
+	}
+	*v = vectorClass().vectorFromComplex(values)
+	return nil
+}
+
+func (v vector_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *vector_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a vector: %v",
+			source,
+		)
+	}
+}
+
+// Private Methods
+
+// This private method returns the complex values for the CBOR array of [real,
+// imaginary] pairs, or false if the CBOR value is not such an array.
+func (c *vectorClass_) complexFromCbor(
+	value any,
+) (
+	values []complex128,
+	ok bool,
+) {
+	var items []any
+	items, ok = value.([]any)
+	if !ok {
+		return
+	}
+	values = make([]complex128, len(items))
+	for index, item := range items {
+		var parts, _ = item.([]any)
+		if len(parts) != 2 {
+			ok = false
+			return
+		}
+		var realPart, imaginaryPart float64
+		realPart, ok = c.floatFromCbor(parts[0])
+		if !ok {
+			return
+		}
+		imaginaryPart, ok = c.floatFromCbor(parts[1])
+		if !ok {
+			return
+		}
+		values[index] = complex(realPart, imaginaryPart)
+	}
+	return
+}
+
+// This private method returns the complex value of the specified natively
+// encoded JSON number, which is a real value, an array of its real and
+// imaginary parts, or the source of a number that has no native encoding.
+func (c *vectorClass_) complexFromJson(
+	bytes []byte,
+) (
+	value complex128,
+	err error,
+) {
+	var real_ float64
+	if jsn.Unmarshal(bytes, &real_) == nil {
+		value = complex(real_, 0)
+		return
+	}
+	var parts []float64
+	if jsn.Unmarshal(bytes, &parts) == nil {
+		if len(parts) != 2 {
+			err = fmt.Errorf("A complex number must have exactly two parts: %v", parts)
+			return
+		}
+		value = complex(parts[0], parts[1])
+		return
+	}
+	var source string
+	err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return
+	}
+	var number ele.NumberLike
+	number, err = ele.NumberClass().ParseNumber(source)
+	if err != nil {
+		return
+	}
+	value = number.AsIntrinsic()
+	return
+}
+
+// This private method returns the complex values found in the specified
+// vector source string.
+func (c *vectorClass_) complexFromSource(source string) []complex128 {
+	var elements = source[1 : len(source)-1] // Strip off the brackets.
+	if len(elements) == 0 {
+		return nil
+	}
+	var sources = sts.Split(elements, ", ")
+	var values = make([]complex128, len(sources))
+	for index, source := range sources {
+		values[index] = ele.NumberClass().NumberFromSource(source).AsIntrinsic()
+	}
+	return values
+}
+
+// This private method returns the CBOR array of [real, imaginary] pairs for the
+// specified complex values.
+func (c *vectorClass_) cborFromComplex(values []complex128) []any {
+	var items = make([]any, len(values))
+	for index, value := range values {
+		items[index] = []any{real(value), imag(value)}
+	}
+	return items
+}
+
+// This private method applies the specified number function to each pair of
+// corresponding elements in the specified vectors.
+func (c *vectorClass_) elementwise(
+	first VectorLike,
+	second VectorLike,
+	function func(ele.NumberLike, ele.NumberLike) ele.NumberLike,
+) VectorLike {
+	c.validateSizes(first, second)
+	var firstNumbers = first.AsArray()
+	var secondNumbers = second.AsArray()
+	var numbers = make([]ele.NumberLike, len(firstNumbers))
+	for index := range numbers {
+		numbers[index] = function(firstNumbers[index], secondNumbers[index])
+	}
+	return c.vectorFromNumbers(numbers)
+}
+
+// This private method returns the floating point value of the specified CBOR
+// value, or false if it is not a number.
+func (c *vectorClass_) floatFromCbor(
+	value any,
+) (
+	float float64,
+	ok bool,
+) {
+	ok = true
+	switch actual := value.(type) {
+	case float64:
+		float = actual
+	case uint64:
+		float = float64(actual)
+	case int64:
+		float = float64(actual)
+	default:
+		ok = false
+	}
+	return
+}
+
+// This private method panics if the specified vectors differ in size.
+func (c *vectorClass_) validateSizes(
+	first VectorLike,
+	second VectorLike,
+) {
+	if first.GetSize() != second.GetSize() {
+		var message = fmt.Sprintf(
+			"The vectors must be the same size: %v and %v",
+			first.GetSize(),
+			second.GetSize(),
+		)
+		panic(message)
+	}
+}
+
+// This private method returns the vector containing the specified complex
+// values, each normalized the same way as a number.
+func (c *vectorClass_) vectorFromComplex(values []complex128) vector_ {
+	var bytes = make([]byte, 0, 16*len(values))
+	for _, value := range values {
+		var number = ele.NumberClass().Number(value).AsIntrinsic()
+		bytes = bin.BigEndian.AppendUint64(bytes, mat.Float64bits(real(number)))
+		bytes = bin.BigEndian.AppendUint64(bytes, mat.Float64bits(imag(number)))
+	}
+	return vector_(bytes)
+}
+
+// This private method returns the vector containing the specified numbers.
+func (c *vectorClass_) vectorFromNumbers(numbers []ele.NumberLike) vector_ {
+	var values = make([]complex128, len(numbers))
+	for index, number := range numbers {
+		values[index] = number.AsIntrinsic()
+	}
+	return c.vectorFromComplex(values)
+}
+
+// Instance Structure
+
+type vector_ string // The big-endian float64 real and imaginary part of each element.
+
+// Class Structure
+
+type vectorClass_ struct {
+	// Declare the class constants.
+	matcher_     *reg.Regexp
+	productions_ map[int]string
+	cborTag_     uint64
+}
+
+// Class Reference
+
+func vectorClass() *vectorClass_ {
+	return vectorClassReference_
+}
+
+// The vector matcher is composed from the number matcher (minus its leading "^"
+// anchor) so its capture groups are numbered as follows:
+//   - 1: the first element
+//   - numberGroups + 2: each additional element
+var vectorClassReference_ = &vectorClass_{
+	// Initialize the class constants.
+	matcher_: reg.MustCompile(
+		"^\\[(?:(" + gra.Matcher("number").String()[1:] + ")(?:, (" +
+			gra.Matcher("number").String()[1:] + "))*)?\\]",
+	),
+	productions_: map[int]string{
+		1:                                     "element",
+		gra.Matcher("number").NumSubexp() + 2: "element",
+	},
+	cborTag_: 0x6364671c,
+}
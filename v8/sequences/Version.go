@@ -13,7 +13,12 @@
 package sequences
 
 import (
+	drv "database/sql/driver"
+	bin "encoding/binary"
+	jsn "encoding/json"
 	fmt "fmt"
+	ele "github.com/craterdog/go-essential-primitives/v8/elements"
+	gra "github.com/craterdog/go-essential-primitives/v8/internal/grammar"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	reg "regexp"
 	sli "slices"
@@ -48,20 +53,72 @@ func (c *versionClass_) VersionFromSequence(
 	return c.Version(sequence.AsArray())
 }
 
-func (c *versionClass_) VersionFromSource(
-	source string,
+func (c *versionClass_) VersionFromSemver(
+	semver string,
 ) VersionLike {
-	var matches = c.matcher_.FindStringSubmatch(source)
+	var matches = gra.MatchSource(c.semverMatcher_, semver)
 	if uti.IsUndefined(matches) {
 		var message = fmt.Sprintf(
-			"An illegal string was passed to the version constructor method: %s",
-			source,
+			"The string is not a semantic version: %v",
+			semver,
+		)
+		panic(message)
+	}
+	if len(matches[4]) > 0 {
+		var message = fmt.Sprintf(
+			"A version cannot have a semantic pre-release suffix: %v",
+			semver,
+		)
+		panic(message)
+	}
+
+	// Trailing zero ordinals are dropped since versions have no zero ordinals.
+	var ordinals = matches[1:4]
+	for len(ordinals) > 0 && ordinals[len(ordinals)-1] == "0" {
+		ordinals = ordinals[:len(ordinals)-1]
+	}
+	var source = "v" + sts.Join(ordinals, ".")
+	if c.matcher_.FindString(source) != source {
+		// A zero ordinal remains (e.g. "0.3.1" or "1.0.2").
+		var message = fmt.Sprintf(
+			"The semantic version has no equivalent version: %v",
+			semver,
 		)
 		panic(message)
 	}
 	return version_(source)
 }
 
+func (c *versionClass_) VersionFromSource(
+	source string,
+) VersionLike {
+	var version, err = c.ParseVersion(source)
+	if err != nil {
+		panic(err.Error())
+	}
+	return version
+}
+
+func (c *versionClass_) ParseVersion(
+	source string,
+) (
+	version VersionLike,
+	err error,
+) {
+	var matches = gra.MatchSource(c.matcher_, source)
+	if uti.IsUndefined(matches) {
+		err = ele.ParseErrorClass().ParseErrorFromMatcher(
+			"version",
+			c.matcher_,
+			c.productions_,
+			source,
+		)
+		return
+	}
+	version = version_(source)
+	return
+}
+
 // Constant Methods
 
 // Function Methods
@@ -162,6 +219,18 @@ func (v version_) AsSource() string {
 	return string(v)
 }
 
+func (v version_) AsSemver() string {
+	var ordinals = v.AsIntrinsic()
+	if len(ordinals) > 3 {
+		// Semantic versions have exactly three ordinals.
+		return ""
+	}
+	for len(ordinals) < 3 {
+		ordinals = append(ordinals, 0)
+	}
+	return fmt.Sprintf("%d.%d.%d", ordinals[0], ordinals[1], ordinals[2])
+}
+
 // Attribute Methods
 
 // Accessible[uint] Methods
@@ -273,6 +342,157 @@ func (v version_) String() string {
 	return v.AsSource()
 }
 
+func (v version_) MarshalText() (
+	text []byte,
+	err error,
+) {
+	text = []byte(v.AsSource())
+	return
+}
+
+func (v *version_) UnmarshalText(
+	text []byte,
+) error {
+	var version, err = versionClass().ParseVersion(string(text))
+	if err != nil {
+		return err
+	}
+	*v = version.(version_)
+	return nil
+}
+
+func (v version_) MarshalJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsSource())
+}
+
+func (v *version_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(source))
+}
+
+func (v version_) MarshalNativeJSON() (
+	bytes []byte,
+	err error,
+) {
+	return jsn.Marshal(v.AsIntrinsic())
+}
+
+func (v *version_) UnmarshalNativeJSON(
+	bytes []byte,
+) error {
+	var ordinals []uint
+	var err = jsn.Unmarshal(bytes, &ordinals)
+	if err != nil {
+		return err
+	}
+	if len(ordinals) == 0 {
+		return fmt.Errorf("A version must have at least one ordinal.")
+	}
+	return v.UnmarshalText([]byte(versionClass().Version(ordinals).AsSource()))
+}
+
+func (v version_) MarshalBinary() (
+	bytes []byte,
+	err error,
+) {
+	bytes = ele.FormatClass().Header(VersionType)
+	for _, ordinal := range v.AsIntrinsic() {
+		bytes = bin.AppendUvarint(bytes, uint64(ordinal))
+	}
+	return
+}
+
+func (v *version_) UnmarshalBinary(
+	bytes []byte,
+) error {
+	var payload, err = ele.FormatClass().Payload(VersionType, bytes)
+	if err != nil {
+		return err
+	}
+	var ordinals []uint
+	for len(payload) > 0 {
+		var ordinal, size = bin.Uvarint(payload)
+		if size <= 0 {
+			return fmt.Errorf("The binary payload is not a version: %x", payload)
+		}
+		ordinals = append(ordinals, uint(ordinal))
+		payload = payload[size:]
+	}
+	if len(ordinals) == 0 {
+		return fmt.Errorf("A version must have at least one ordinal.")
+	}
+	return v.UnmarshalText([]byte(versionClass().Version(ordinals).AsSource()))
+}
+
+func (v version_) MarshalCBOR() (
+	bytes []byte,
+	err error,
+) {
+	var ordinals []any
+	for _, ordinal := range v.AsIntrinsic() {
+		ordinals = append(ordinals, uint64(ordinal))
+	}
+	return ele.CborClass().Encode(versionClass().cborTag_, ordinals)
+}
+
+func (v *version_) UnmarshalCBOR(
+	bytes []byte,
+) error {
+	var tag, value, err = ele.CborClass().Decode(bytes)
+	if err != nil {
+		return err
+	}
+	if tag != versionClass().cborTag_ {
+		return fmt.Errorf("The CBOR data item is not a version: %x", bytes)
+	}
+	var items, _ = value.([]any)
+	if len(items) == 0 {
+		return fmt.Errorf("A version must have at least one ordinal.")
+	}
+	var ordinals = make([]uint, len(items))
+	for index, item := range items {
+		var ordinal, ok = item.(uint64)
+		if !ok {
+			return fmt.Errorf("The CBOR data item is not a version: %x", bytes)
+		}
+		ordinals[index] = uint(ordinal)
+	}
+	return v.UnmarshalText([]byte(versionClass().Version(ordinals).AsSource()))
+}
+
+func (v version_) Value() (
+	value drv.Value,
+	err error,
+) {
+	value = v.AsSource()
+	return
+}
+
+func (v *version_) Scan(
+	source any,
+) error {
+	switch actual := source.(type) {
+	case string:
+		return v.UnmarshalText([]byte(actual))
+	case []byte:
+		return v.UnmarshalText(actual)
+	default:
+		return fmt.Errorf(
+			"The database value cannot be scanned into a version: %v",
+			source,
+		)
+	}
+}
+
 // Private Methods
 
 // NOTE:
@@ -294,7 +514,10 @@ type version_ string // This type must support the "comparable" type contraint.
 
 type versionClass_ struct {
 	// Declare the class constants.
-	matcher_ *reg.Regexp
+	matcher_       *reg.Regexp
+	semverMatcher_ *reg.Regexp
+	productions_   map[int]string
+	cborTag_       uint64
 }
 
 // Class Reference
@@ -308,4 +531,12 @@ var versionClassReference_ = &versionClass_{
 	matcher_: reg.MustCompile(
 		"^v(" + ordinal_ + "(?:\\." + ordinal_ + ")*)",
 	),
+	semverMatcher_: reg.MustCompile(
+		"^v?(0|" + ordinal_ + ")\\.(0|" + ordinal_ + ")\\.(0|" + ordinal_ +
+			")(?:-([0-9A-Za-z.-]+))?(?:\\+[0-9A-Za-z.-]+)?$",
+	),
+	productions_: map[int]string{
+		1: "version ordinals",
+	},
+	cborTag_: 0x6364671a,
 }
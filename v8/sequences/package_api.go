@@ -27,16 +27,41 @@ Additional concrete implementations of the classes declared by this package can
 be developed and used seamlessly since the interface declarations only depend on
 other interfaces and intrinsic types—and the class implementations only depend
 on interfaces, not on each other.
+
+Each primitive implements the sql.Scanner and driver.Valuer interfaces so that
+it may be stored in a database column.  A binary or tag is stored as its raw
+bytes (e.g. bytea) and all other primitives as their canonical source text.
 */
 package sequences
 
 import (
+	ele "github.com/craterdog/go-essential-primitives/v8/elements"
 	uti "github.com/craterdog/go-essential-utilities/v8"
+	io "io"
 	reg "regexp"
 )
 
 // TYPE DECLARATIONS
 
+/*
+These constants define the type bytes that identify the class of each primitive
+declared in this package within its binary encoding.
+*/
+const (
+	BinaryType ele.Type = iota + 0x11
+	BytecodeType
+	IdentifierType
+	NameType
+	NarrativeType
+	PatternType
+	QuoteType
+	SymbolType
+	TagType
+	VersionType
+	MatrixType
+	VectorType
+)
+
 // FUNCTIONAL DECLARATIONS
 
 // CLASS DECLARATIONS
@@ -57,6 +82,12 @@ type BinaryClassLike interface {
 	BinaryFromSource(
 		source string,
 	) BinaryLike
+	ParseBinary(
+		source string,
+	) (
+		binary BinaryLike,
+		err error,
+	)
 
 	// Function Methods
 	Not(
@@ -100,6 +131,14 @@ type BytecodeClassLike interface {
 	BytecodeFromSource(
 		source string,
 	) BytecodeLike
+	ParseBytecode(
+		source string,
+	) (
+		bytecode BytecodeLike,
+		err error,
+	)
+
+	// Constant Methods
 }
 
 /*
@@ -121,11 +160,102 @@ type IdentifierClassLike interface {
 	IdentifierFromSource(
 		source string,
 	) IdentifierLike
+	ParseIdentifier(
+		source string,
+	) (
+		identifier IdentifierLike,
+		err error,
+	)
 
 	// Constant Methods
 	Undefined() IdentifierLike
 }
 
+/*
+MatrixClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+matrix-like concrete class.
+
+A matrix has at least one row and one column and its rows must all be the same
+size (e.g. "[[1, 2], [3, 4]]").  The Inverse(), Sum(), Difference(), Product()
+and Quotient() functions are applied elementwise just as they are for vectors,
+whereas the MatrixProduct() function performs true matrix multiplication.  The
+Reciprocal() function returns the inverse of a square matrix and panics if the
+matrix is singular.  The Rotation() constructor returns the 2x2 matrix that
+rotates a vector counterclockwise by the specified angle and RotationAbout()
+returns the 3x3 matrix that rotates a vector about the specified real axis.
+*/
+type MatrixClassLike interface {
+	// Constructor Methods
+	Matrix(
+		values [][]complex128,
+	) MatrixLike
+	MatrixFromSequence(
+		sequence Sequential[VectorLike],
+	) MatrixLike
+	Identity(
+		size uint,
+	) MatrixLike
+	Rotation(
+		angle ele.AngleLike,
+	) MatrixLike
+	RotationAbout(
+		axis VectorLike,
+		angle ele.AngleLike,
+	) MatrixLike
+	MatrixFromSource(
+		source string,
+	) MatrixLike
+	ParseMatrix(
+		source string,
+	) (
+		matrix MatrixLike,
+		err error,
+	)
+
+	// Function Methods
+	Inverse(
+		matrix MatrixLike,
+	) MatrixLike
+	Sum(
+		first MatrixLike,
+		second MatrixLike,
+	) MatrixLike
+	Difference(
+		first MatrixLike,
+		second MatrixLike,
+	) MatrixLike
+	Scaled(
+		matrix MatrixLike,
+		factor ele.NumberLike,
+	) MatrixLike
+	Product(
+		first MatrixLike,
+		second MatrixLike,
+	) MatrixLike
+	Quotient(
+		first MatrixLike,
+		second MatrixLike,
+	) MatrixLike
+	MatrixProduct(
+		first MatrixLike,
+		second MatrixLike,
+	) MatrixLike
+	Transformed(
+		matrix MatrixLike,
+		vector VectorLike,
+	) VectorLike
+	Transposed(
+		matrix MatrixLike,
+	) MatrixLike
+	Determinant(
+		matrix MatrixLike,
+	) ele.NumberLike
+	Reciprocal(
+		matrix MatrixLike,
+	) MatrixLike
+}
+
 /*
 NameClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -142,6 +272,12 @@ type NameClassLike interface {
 	NameFromSource(
 		source string,
 	) NameLike
+	ParseName(
+		source string,
+	) (
+		name NameLike,
+		err error,
+	)
 
 	// Function Methods
 	Concatenate(
@@ -166,6 +302,12 @@ type NarrativeClassLike interface {
 	NarrativeFromSource(
 		source string,
 	) NarrativeLike
+	ParseNarrative(
+		source string,
+	) (
+		narrative NarrativeLike,
+		err error,
+	)
 
 	// Function Methods
 	Concatenate(
@@ -190,6 +332,12 @@ type PatternClassLike interface {
 	PatternFromSource(
 		source string,
 	) PatternLike
+	ParsePattern(
+		source string,
+	) (
+		pattern PatternLike,
+		err error,
+	)
 
 	// Constant Methods
 	None() PatternLike
@@ -218,6 +366,12 @@ type QuoteClassLike interface {
 	QuoteFromSource(
 		source string,
 	) QuoteLike
+	ParseQuote(
+		source string,
+	) (
+		quote QuoteLike,
+		err error,
+	)
 
 	// Function Methods
 	Concatenate(
@@ -226,6 +380,28 @@ type QuoteClassLike interface {
 	) QuoteLike
 }
 
+/*
+ScannerClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+scanner-like concrete class.
+
+A scanner reads the source for a sequence of primitives from a reader and
+recognizes each primitive literal—from both the elements and sequences
+packages—using the matchers for the corresponding classes.  Whitespace,
+comments ("!>" through "<!") and notes ("!" through the end of the line) that
+separate the primitives are skipped.  When more than one primitive matches at
+the same position, the longest match wins.  The scanner reads ahead no further
+than needed to find the end of a token, and at most 1 MiB, so a malformed token
+is reported at the offset where every candidate failed and a longer token is
+reported as an error.
+*/
+type ScannerClassLike interface {
+	// Constructor Methods
+	Scanner(
+		reader io.Reader,
+	) ScannerLike
+}
+
 /*
 SymbolClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -242,6 +418,12 @@ type SymbolClassLike interface {
 	SymbolFromSource(
 		source string,
 	) SymbolLike
+	ParseSymbol(
+		source string,
+	) (
+		symbol SymbolLike,
+		err error,
+	)
 
 	// Constant Methods
 	Undefined() SymbolLike
@@ -266,6 +448,12 @@ type TagClassLike interface {
 	TagFromSource(
 		source string,
 	) TagLike
+	ParseTag(
+		source string,
+	) (
+		tag TagLike,
+		err error,
+	)
 
 	// Function Methods
 	Concatenate(
@@ -274,10 +462,83 @@ type TagClassLike interface {
 	) TagLike
 }
 
+/*
+VectorClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+vector-like concrete class.
+
+The elements of a vector are numbers (e.g. "[1, 2i, -3]") and are normalized
+and rendered using the current precision policy of the number class.  The
+Inverse(), Sum(), Difference(), Product() and Quotient() functions are applied
+elementwise and panic if the vectors differ in size.  The DotProduct() function
+does not conjugate its second vector and the CrossProduct() function requires
+two vectors of size 3.
+*/
+type VectorClassLike interface {
+	// Constructor Methods
+	Vector(
+		values []complex128,
+	) VectorLike
+	VectorFromSequence(
+		sequence Sequential[ele.NumberLike],
+	) VectorLike
+	VectorFromSource(
+		source string,
+	) VectorLike
+	ParseVector(
+		source string,
+	) (
+		vector VectorLike,
+		err error,
+	)
+
+	// Function Methods
+	Inverse(
+		vector VectorLike,
+	) VectorLike
+	Sum(
+		first VectorLike,
+		second VectorLike,
+	) VectorLike
+	Difference(
+		first VectorLike,
+		second VectorLike,
+	) VectorLike
+	Scaled(
+		vector VectorLike,
+		factor ele.NumberLike,
+	) VectorLike
+	Product(
+		first VectorLike,
+		second VectorLike,
+	) VectorLike
+	Quotient(
+		first VectorLike,
+		second VectorLike,
+	) VectorLike
+	DotProduct(
+		first VectorLike,
+		second VectorLike,
+	) ele.NumberLike
+	CrossProduct(
+		first VectorLike,
+		second VectorLike,
+	) VectorLike
+}
+
 /*
 VersionClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
 version-like concrete class.
+
+A version may be converted to and from a semantic version string (e.g.
+"1.2.0").  Since the ordinals of a version are never zero, the trailing zero
+ordinals of a semantic version are dropped (e.g. "1.2.0" becomes "v1.2"), its
+build metadata is ignored, and the VersionFromSemver() constructor panics if
+the semantic version has a pre-release suffix or a zero ordinal before a
+non-zero one (e.g. "0.3.1").  The AsSemver() method pads a version with zero
+ordinals and returns an empty string if the version has more than three
+ordinals.
 */
 type VersionClassLike interface {
 	// Constructor Methods
@@ -287,9 +548,18 @@ type VersionClassLike interface {
 	VersionFromSequence(
 		sequence Sequential[uint],
 	) VersionLike
+	VersionFromSemver(
+		semver string,
+	) VersionLike
 	VersionFromSource(
 		source string,
 	) VersionLike
+	ParseVersion(
+		source string,
+	) (
+		version VersionLike,
+		err error,
+	)
 
 	// Function Methods
 	IsValidNextVersion(
@@ -356,6 +626,35 @@ type IdentifierLike interface {
 	Sequential[rune]
 }
 
+/*
+MatrixLike is an instance interface that declares the complete set of principal,
+attribute and aspect methods that must be supported by each instance of a
+concrete matrix-like class.  A matrix is a sequence of its rows.
+*/
+type MatrixLike interface {
+	// Principal Methods
+	GetClass() MatrixClassLike
+	AsIntrinsic() [][]complex128
+	AsSource() string
+
+	// Attribute Methods
+	GetRows() uint
+	GetColumns() uint
+	GetElement(
+		row int,
+		column int,
+	) ele.NumberLike
+	GetRow(
+		index int,
+	) VectorLike
+	GetColumn(
+		index int,
+	) VectorLike
+
+	// Aspect Interfaces
+	Sequential[VectorLike]
+}
+
 /*
 NameLike is an instance interface that declares the complete set of principal,
 attribute and aspect methods that must be supported by each instance of a
@@ -433,6 +732,30 @@ type QuoteLike interface {
 	Sequential[rune]
 }
 
+/*
+ScannerLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete scanner-like class.
+
+The ScanToken() method advances the scanner to the next token and returns false
+when there are no more tokens or an error occurred.  The kind, primitive and
+byte offsets (start inclusive, end exclusive) of the current token are then
+available from the attribute methods.  The offset of a parse error is relative
+to the start of the token that failed.
+*/
+type ScannerLike interface {
+	// Principal Methods
+	GetClass() ScannerClassLike
+	ScanToken() bool
+
+	// Attribute Methods
+	GetKind() string
+	GetPrimitive() any
+	GetStart() uint
+	GetEnd() uint
+	GetError() error
+}
+
 /*
 SymbolLike is an instance interface that declares the complete set of principal,
 attribute and aspect methods that must be supported by each instance of a
@@ -469,6 +792,22 @@ type TagLike interface {
 	Sequential[byte]
 }
 
+/*
+VectorLike is an instance interface that declares the complete set of principal,
+attribute and aspect methods that must be supported by each instance of a
+concrete vector-like class.
+*/
+type VectorLike interface {
+	// Principal Methods
+	GetClass() VectorClassLike
+	AsIntrinsic() []complex128
+	AsSource() string
+
+	// Aspect Interfaces
+	Accessible[ele.NumberLike]
+	Sequential[ele.NumberLike]
+}
+
 /*
 VersionLike is an instance interface that declares the complete set of principal,
 attribute and aspect methods that must be supported by each instance of a
@@ -479,6 +818,7 @@ type VersionLike interface {
 	GetClass() VersionClassLike
 	AsIntrinsic() []uint
 	AsSource() string
+	AsSemver() string
 
 	// Aspect Interfaces
 	Accessible[uint]
@@ -524,14 +864,11 @@ type Accessible[V any] interface {
 
 /*
 Ordered[V any] is an aspect interface that declares a set of method signatures
-that must be supported by each instance of an ordered concrete class.
+that must be supported by each instance of an ordered concrete class.  It is
+declared by the elements package so that ordered elements and ordered sequences
+share the same aspect.
 */
-type Ordered[V any] interface {
-	AsSource() string
-	IsBefore(
-		value V,
-	) bool
-}
+type Ordered[V any] = ele.Ordered[V]
 
 /*
 Searchable[V any] is an aspect interface that declares a set of method